@@ -0,0 +1,85 @@
+package analysis
+
+import (
+	analysisv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1/analysis"
+)
+
+// Provider produces a single measurement for a metric and reports whether the measurement
+// satisfied the metric's SuccessCondition.
+type Provider interface {
+	Run(metric analysisv1alpha1.Metric) (value string, successful bool, err error)
+}
+
+// Controller evaluates the metrics of an AnalysisRun against their configured providers. It does
+// not own any informers of its own; the Rollout controller invokes Reconcile when it observes an
+// AnalysisRun whose phase has not been set yet.
+type Controller struct {
+	providers map[string]Provider
+}
+
+// NewController returns an analysis controller backed by the given set of metric providers, keyed
+// by provider name ("prometheus", "datadog", "web").
+func NewController(providers map[string]Provider) *Controller {
+	return &Controller{providers: providers}
+}
+
+// NewDefaultController returns an analysis controller wired with this repo's built-in metric
+// providers.
+func NewDefaultController() *Controller {
+	return NewController(map[string]Provider{
+		"prometheus": NewPrometheusProvider(),
+	})
+}
+
+func (c *Controller) providerFor(metric analysisv1alpha1.Metric) Provider {
+	switch {
+	case metric.Provider.Prometheus != nil:
+		return c.providers["prometheus"]
+	case metric.Provider.Datadog != nil:
+		return c.providers["datadog"]
+	case metric.Provider.Web != nil:
+		return c.providers["web"]
+	default:
+		return nil
+	}
+}
+
+// Reconcile evaluates every metric declared on the AnalysisRun and returns the status that should
+// be persisted. The run is considered Successful only if every metric measurement satisfies its
+// SuccessCondition; any provider error or unmet condition fails the run.
+func (c *Controller) Reconcile(run *analysisv1alpha1.AnalysisRun) analysisv1alpha1.AnalysisRunStatus {
+	results := make([]analysisv1alpha1.MetricResult, 0, len(run.Spec.Metrics))
+	phase := analysisv1alpha1.AnalysisPhaseSuccessful
+	for _, metric := range run.Spec.Metrics {
+		provider := c.providerFor(metric)
+		if provider == nil {
+			results = append(results, analysisv1alpha1.MetricResult{
+				Name:  metric.Name,
+				Phase: analysisv1alpha1.AnalysisPhaseFailed,
+			})
+			phase = analysisv1alpha1.AnalysisPhaseFailed
+			continue
+		}
+		value, successful, err := provider.Run(metric)
+		metricPhase := analysisv1alpha1.AnalysisPhaseSuccessful
+		if err != nil || !successful {
+			metricPhase = analysisv1alpha1.AnalysisPhaseFailed
+		}
+		results = append(results, analysisv1alpha1.MetricResult{
+			Name:  metric.Name,
+			Phase: metricPhase,
+			Value: value,
+		})
+		if metricPhase == analysisv1alpha1.AnalysisPhaseFailed {
+			phase = analysisv1alpha1.AnalysisPhaseFailed
+		}
+	}
+	status := analysisv1alpha1.AnalysisRunStatus{
+		Phase:         phase,
+		MetricResults: results,
+	}
+	if phase == analysisv1alpha1.AnalysisPhaseFailed {
+		status.Message = "one or more metrics failed"
+	}
+	return status
+}
@@ -0,0 +1,100 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	analysisv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1/analysis"
+)
+
+// PrometheusProvider queries a Prometheus server's HTTP API for a metric's instant value and
+// evaluates it against the metric's SuccessCondition.
+type PrometheusProvider struct {
+	client *http.Client
+}
+
+// NewPrometheusProvider returns a Provider that queries the Prometheus server named by each
+// metric's Provider.Prometheus.Address.
+func NewPrometheusProvider() *PrometheusProvider {
+	return &PrometheusProvider{client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// Run queries metric.Provider.Prometheus.Address for metric.Provider.Prometheus.Query and
+// evaluates the single returned value against metric.SuccessCondition.
+func (p *PrometheusProvider) Run(metric analysisv1alpha1.Metric) (string, bool, error) {
+	prom := metric.Provider.Prometheus
+	if prom == nil {
+		return "", false, fmt.Errorf("metric %s: no prometheus provider configured", metric.Name)
+	}
+	queryURL := fmt.Sprintf("%s/api/v1/query?query=%s", prom.Address, url.QueryEscape(prom.Query))
+	resp, err := p.client.Get(queryURL)
+	if err != nil {
+		return "", false, fmt.Errorf("metric %s: %w", metric.Name, err)
+	}
+	defer resp.Body.Close()
+
+	var result prometheusQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", false, fmt.Errorf("metric %s: decoding prometheus response: %w", metric.Name, err)
+	}
+	if result.Status != "success" || len(result.Data.Result) == 0 || len(result.Data.Result[0].Value) != 2 {
+		return "", false, fmt.Errorf("metric %s: prometheus query returned no results", metric.Name)
+	}
+
+	value := fmt.Sprintf("%v", result.Data.Result[0].Value[1])
+	successful, err := evaluateSuccessCondition(value, metric.SuccessCondition)
+	if err != nil {
+		return value, false, fmt.Errorf("metric %s: %w", metric.Name, err)
+	}
+	return value, successful, nil
+}
+
+var successConditionRegexp = regexp.MustCompile(`^result\s*(>=|<=|==|!=|>|<)\s*([-+]?[0-9]*\.?[0-9]+)$`)
+
+// evaluateSuccessCondition supports the common "result <op> <number>" comparisons used by
+// Prometheus-based success conditions (e.g. "result >= 0.95"); anything more expressive isn't
+// needed yet since no provider in this repo currently emits non-numeric measurements.
+func evaluateSuccessCondition(value, condition string) (bool, error) {
+	matches := successConditionRegexp.FindStringSubmatch(condition)
+	if matches == nil {
+		return false, fmt.Errorf("unsupported success condition %q", condition)
+	}
+	actual, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false, fmt.Errorf("measured value %q is not numeric: %w", value, err)
+	}
+	threshold, err := strconv.ParseFloat(matches[2], 64)
+	if err != nil {
+		return false, err
+	}
+	switch matches[1] {
+	case ">=":
+		return actual >= threshold, nil
+	case "<=":
+		return actual <= threshold, nil
+	case "==":
+		return actual == threshold, nil
+	case "!=":
+		return actual != threshold, nil
+	case ">":
+		return actual > threshold, nil
+	case "<":
+		return actual < threshold, nil
+	default:
+		return false, fmt.Errorf("unsupported operator %q", matches[1])
+	}
+}
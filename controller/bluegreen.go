@@ -0,0 +1,410 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1/analysis"
+	"github.com/argoproj/argo-rollouts/pkg/trafficrouting"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+var rolloutKind = v1alpha1.SchemeGroupVersion.WithKind("Rollout")
+
+// reconcileVerifyingPreview returns whether or not the rollout is still waiting on a manual or
+// automated gate (the `verifyingPreview` flag) before the active service selector is switched to
+// the new ReplicaSet.
+func (c *Controller) reconcileVerifyingPreview(activeSvc *corev1.Service, rollout *v1alpha1.Rollout) bool {
+	rolloutSpecBlueGreen := rollout.Spec.Strategy.BlueGreenStrategy
+	if rolloutSpecBlueGreen.PreviewService == "" {
+		return false
+	}
+	if _, ok := activeSvc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey]; !ok {
+		return false
+	}
+	if rollout.Status.VerifyingPreview != nil {
+		return *rollout.Status.VerifyingPreview
+	}
+	return false
+}
+
+// reconcilePrePromotionHooks runs the hooks in BlueGreenStrategy.PrePromotionHooks and reports
+// whether every hook has reached the Successful phase. The active service selector must not be
+// switched to the new ReplicaSet until this returns true.
+func (c *Controller) reconcilePrePromotionHooks(rollout *v1alpha1.Rollout) (bool, error) {
+	rolloutSpecBlueGreen := rollout.Spec.Strategy.BlueGreenStrategy
+	allSucceeded, statuses, err := c.runHooks(rollout, "pre", rolloutSpecBlueGreen.PrePromotionHooks, rollout.Status.PrePromotionHookStatuses)
+	rollout.Status.PrePromotionHookStatuses = statuses
+	return allSucceeded, err
+}
+
+// reconcilePostPromotionHooks runs the hooks in BlueGreenStrategy.PostPromotionHooks and reports
+// whether every hook has reached the Successful phase. The previous stable ReplicaSet must not be
+// scaled down until this returns true.
+func (c *Controller) reconcilePostPromotionHooks(rollout *v1alpha1.Rollout) (bool, error) {
+	rolloutSpecBlueGreen := rollout.Spec.Strategy.BlueGreenStrategy
+	allSucceeded, statuses, err := c.runHooks(rollout, "post", rolloutSpecBlueGreen.PostPromotionHooks, rollout.Status.PostPromotionHookStatuses)
+	rollout.Status.PostPromotionHookStatuses = statuses
+	return allSucceeded, err
+}
+
+// runHooks ensures a Job exists for every RolloutHook, and folds the observed Job status into the
+// HookStatus list that gets persisted on RolloutStatus. It returns true once every hook has
+// reached HookPhaseSuccessful. A hook that reaches HookPhaseFailed (including by exceeding its
+// TimeoutSeconds) is returned as an error so the caller can mark the rollout Degraded.
+func (c *Controller) runHooks(rollout *v1alpha1.Rollout, prefix string, hooks []v1alpha1.RolloutHook, prevStatuses []v1alpha1.HookStatus) (bool, []v1alpha1.HookStatus, error) {
+	if len(hooks) == 0 {
+		return true, prevStatuses, nil
+	}
+
+	newStatuses := make([]v1alpha1.HookStatus, 0, len(hooks))
+	allSucceeded := true
+	var firstErr error
+	for _, hook := range hooks {
+		jobName := fmt.Sprintf("%s-%s-%s", rollout.Name, prefix, hook.Name)
+		job, err := c.jobLister.Jobs(rollout.Namespace).Get(jobName)
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return false, prevStatuses, err
+			}
+			job, err = c.createHookJob(rollout, jobName, hook)
+			if err != nil {
+				return false, prevStatuses, err
+			}
+		}
+		status := hookStatusFromJob(hook.Name, job)
+		newStatuses = append(newStatuses, status)
+		switch status.Phase {
+		case v1alpha1.HookPhaseSuccessful:
+			continue
+		case v1alpha1.HookPhaseFailed:
+			allSucceeded = false
+			if firstErr == nil {
+				firstErr = fmt.Errorf("hook job %s failed: %s", jobName, status.Message)
+			}
+		default:
+			allSucceeded = false
+		}
+	}
+	return allSucceeded, newStatuses, firstErr
+}
+
+func (c *Controller) createHookJob(rollout *v1alpha1.Rollout, jobName string, hook v1alpha1.RolloutHook) (*batchv1.Job, error) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   rollout.Namespace,
+			Labels:      hook.JobTemplate.Labels,
+			Annotations: hook.JobTemplate.Annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(rollout, rolloutKind),
+			},
+		},
+		Spec: hook.JobTemplate.Spec,
+	}
+	job.Spec.BackoffLimit = hook.BackoffLimit
+	job.Spec.ActiveDeadlineSeconds = secondsToInt64Ptr(hook.TimeoutSeconds)
+	created, err := c.kubeclientset.BatchV1().Jobs(rollout.Namespace).Create(context.TODO(), job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.Eventf(rollout, corev1.EventTypeNormal, "HookJobCreated", "created hook job %s", jobName)
+	return created, nil
+}
+
+func hookStatusFromJob(name string, job *batchv1.Job) v1alpha1.HookStatus {
+	status := v1alpha1.HookStatus{
+		Name:      name,
+		Phase:     v1alpha1.HookPhasePending,
+		StartTime: job.Status.StartTime,
+	}
+	switch {
+	case job.Status.Succeeded > 0:
+		status.Phase = v1alpha1.HookPhaseSuccessful
+		status.CompletionTime = job.Status.CompletionTime
+		status.Message = "hook job succeeded"
+	case job.Status.Failed > 0:
+		status.Phase = v1alpha1.HookPhaseFailed
+		status.CompletionTime = job.Status.CompletionTime
+		status.Message = "hook job failed"
+	case job.Status.Active > 0:
+		status.Phase = v1alpha1.HookPhaseRunning
+	}
+	return status
+}
+
+// rollbackActiveServiceSelector resets the active service's pod-hash selector back to the last
+// known-good StableRS, used when a required promotion hook fails.
+func (c *Controller) rollbackActiveServiceSelector(rollout *v1alpha1.Rollout, activeSvc *corev1.Service) error {
+	if rollout.Status.StableRS == "" {
+		return nil
+	}
+	patch := fmt.Sprintf(`{"spec":{"selector":{"%s":"%s"}}}`, v1alpha1.DefaultRolloutUniqueLabelKey, rollout.Status.StableRS)
+	_, err := c.kubeclientset.CoreV1().Services(activeSvc.Namespace).Patch(context.TODO(), activeSvc.Name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	c.recorder.Eventf(rollout, corev1.EventTypeWarning, "RollbackPromotion", "rolled back active service %s to stable pod hash %s", activeSvc.Name, rollout.Status.StableRS)
+	return nil
+}
+
+func secondsToInt64Ptr(seconds *int32) *int64 {
+	if seconds == nil {
+		return nil
+	}
+	v := int64(*seconds)
+	return &v
+}
+
+// reconcileAnalysisRun instantiates an AnalysisRun from the rollout's AnalysisTemplateName once
+// the preview ReplicaSet is fully available, and reports whether that run has reached the
+// Successful phase. Until it does, the active service selector must not be switched to previewRS.
+// A Failed run is returned as an error so the caller can leave the active service on the stable
+// ReplicaSet and mark the rollout's AnalysisFailed condition.
+func (c *Controller) reconcileAnalysisRun(rollout *v1alpha1.Rollout, previewRS *appsv1.ReplicaSet) (bool, error) {
+	rolloutSpecBlueGreen := rollout.Spec.Strategy.BlueGreenStrategy
+	if rolloutSpecBlueGreen.AnalysisTemplateName == "" {
+		return true, nil
+	}
+	if previewRS == nil || previewRS.Spec.Replicas == nil || previewRS.Status.AvailableReplicas < *previewRS.Spec.Replicas {
+		return false, nil
+	}
+
+	if rollout.Status.CurrentAnalysisRun == "" {
+		runName := fmt.Sprintf("%s-%s-analysis", rollout.Name, previewRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+		if err := c.createAnalysisRun(rollout, runName, rolloutSpecBlueGreen.AnalysisTemplateName); err != nil {
+			return false, err
+		}
+		rollout.Status.CurrentAnalysisRun = runName
+		return false, nil
+	}
+
+	run, err := c.analysisRunLister.AnalysisRuns(rollout.Namespace).Get(rollout.Status.CurrentAnalysisRun)
+	if err != nil {
+		return false, err
+	}
+
+	if run.Status.Phase == "" {
+		runCopy := run.DeepCopy()
+		runCopy.Status = c.analysisController.Reconcile(run)
+		run, err = c.rolloutsclientset.ArgoprojV1alpha1().AnalysisRuns(rollout.Namespace).UpdateStatus(context.TODO(), runCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	switch run.Status.Phase {
+	case analysis.AnalysisPhaseSuccessful:
+		return true, nil
+	case analysis.AnalysisPhaseFailed:
+		c.recorder.Eventf(rollout, corev1.EventTypeWarning, "PromotionAborted", "AnalysisRun %s failed, leaving active service on stable ReplicaSet", run.Name)
+		cond := conditions.NewRolloutCondition(v1alpha1.AnalysisFailed, corev1.ConditionTrue, v1alpha1.AnalysisRunFailed,
+			fmt.Sprintf("AnalysisRun %q failed", run.Name))
+		rollout.Status.Conditions = append(rollout.Status.Conditions, *cond)
+		rollout.Status.CurrentAnalysisRun = ""
+		return false, fmt.Errorf("analysis run %s failed", run.Name)
+	default:
+		return false, nil
+	}
+}
+
+func (c *Controller) createAnalysisRun(rollout *v1alpha1.Rollout, runName, templateName string) error {
+	template, err := c.analysisTemplateLister.AnalysisTemplates(rollout.Namespace).Get(templateName)
+	if err != nil {
+		return err
+	}
+	run := &analysis.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      runName,
+			Namespace: rollout.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(rollout, rolloutKind),
+			},
+		},
+		Spec: analysis.AnalysisRunSpec{
+			Metrics: template.Spec.Metrics,
+		},
+	}
+	_, err = c.rolloutsclientset.ArgoprojV1alpha1().AnalysisRuns(rollout.Namespace).Create(context.TODO(), run, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	c.recorder.Eventf(rollout, corev1.EventTypeNormal, "AnalysisRunCreated", "created AnalysisRun %s from template %s", runName, templateName)
+	return nil
+}
+
+// blueGreenProgressDeadlineSeconds returns the effective progress deadline for the preview stack,
+// preferring the BlueGreenStrategy override over the rollout-wide setting.
+func blueGreenProgressDeadlineSeconds(rollout *v1alpha1.Rollout) *int32 {
+	rolloutSpecBlueGreen := rollout.Spec.Strategy.BlueGreenStrategy
+	if rolloutSpecBlueGreen.ProgressDeadlineSeconds != nil {
+		return rolloutSpecBlueGreen.ProgressDeadlineSeconds
+	}
+	return rollout.Spec.ProgressDeadlineSeconds
+}
+
+// reconcileBlueGreenProgressDeadline records when the preview ReplicaSet first appeared and, if
+// it still isn't fully available and verified by the configured deadline, marks the rollout as no
+// longer progressing and (when requested) rolls the active service back to the stable ReplicaSet.
+func (c *Controller) reconcileBlueGreenProgressDeadline(rollout *v1alpha1.Rollout, previewRS *appsv1.ReplicaSet, activeSvc *corev1.Service) error {
+	deadlineSeconds := blueGreenProgressDeadlineSeconds(rollout)
+	if deadlineSeconds == nil || previewRS == nil {
+		return nil
+	}
+	// Once promoted, the ReplicaSet is no longer a preview and the deadline no longer applies.
+	if rollout.Status.StableRS != "" && rollout.Status.StableRS == previewRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] {
+		return nil
+	}
+
+	if rollout.Status.BlueGreen.PreviewStartTime == nil {
+		now := metav1.NewTime(time.Now())
+		rollout.Status.BlueGreen.PreviewStartTime = &now
+		return nil
+	}
+
+	deadline := rollout.Status.BlueGreen.PreviewStartTime.Add(time.Duration(*deadlineSeconds) * time.Second)
+	if time.Now().Before(deadline) {
+		return nil
+	}
+
+	previewAvailable := previewRS.Spec.Replicas != nil && previewRS.Status.AvailableReplicas >= *previewRS.Spec.Replicas
+	if previewAvailable && !blueGreenStillVerifying(rollout) {
+		return nil
+	}
+
+	cond := conditions.NewRolloutCondition(v1alpha1.RolloutProgressing, corev1.ConditionFalse, v1alpha1.ProgressDeadlineExceeded,
+		fmt.Sprintf("ReplicaSet %q has timed out progressing.", previewRS.Name))
+	rollout.Status.Conditions = append(rollout.Status.Conditions, *cond)
+
+	if !rollout.Spec.Strategy.BlueGreenStrategy.AutoRollbackOnFailure {
+		return nil
+	}
+
+	if activeSvc != nil {
+		if err := c.rollbackActiveServiceSelector(rollout, activeSvc); err != nil {
+			return err
+		}
+	}
+	return c.scaleReplicaSetToZero(previewRS)
+}
+
+// blueGreenStillVerifying reports whether the preview ReplicaSet is still held by a gate that
+// must clear before it can be promoted: the legacy VerifyingPreview flag, a PrePromotionHooks
+// status that is not Successful (a Failed hook counts too), or an AnalysisRun recorded in
+// CurrentAnalysisRun while the rollout has no AnalysisFailed condition. The run's phase is not
+// read, so a run that has already succeeded counts until the promotion clears CurrentAnalysisRun.
+// The progress deadline does not fire while any of these hold, so a promotion that is simply
+// waiting on a slow (but otherwise healthy) gate is not timed out.
+func blueGreenStillVerifying(rollout *v1alpha1.Rollout) bool {
+	if rollout.Status.VerifyingPreview != nil && *rollout.Status.VerifyingPreview {
+		return true
+	}
+	for _, status := range rollout.Status.PrePromotionHookStatuses {
+		if status.Phase != v1alpha1.HookPhaseSuccessful {
+			return true
+		}
+	}
+	if rollout.Status.CurrentAnalysisRun != "" {
+		for _, cond := range rollout.Status.Conditions {
+			if cond.Type == v1alpha1.AnalysisFailed {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (c *Controller) scaleReplicaSetToZero(rs *appsv1.ReplicaSet) error {
+	rsCopy := rs.DeepCopy()
+	if rsCopy.Annotations == nil {
+		rsCopy.Annotations = map[string]string{}
+	}
+	if rsCopy.Spec.Replicas != nil {
+		rsCopy.Annotations[annotations.DesiredReplicasAnnotation] = strconv.Itoa(int(*rsCopy.Spec.Replicas))
+	}
+	var zero int32
+	rsCopy.Spec.Replicas = &zero
+	_, err := c.kubeclientset.AppsV1().ReplicaSets(rsCopy.Namespace).Update(context.TODO(), rsCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// reconcileBlueGreenTrafficRouting progressively shifts weighted traffic from the stable to the
+// preview ReplicaSet according to BlueGreenStrategy.TrafficRouting.Steps, instead of switching the
+// active Service selector over all at once. It reports true once every step has completed and the
+// preview ReplicaSet is receiving 100% of traffic, at which point the caller can treat the preview
+// ReplicaSet as promoted.
+func (c *Controller) reconcileBlueGreenTrafficRouting(rollout *v1alpha1.Rollout, stableHash, previewHash string) (bool, error) {
+	rolloutSpecBlueGreen := rollout.Spec.Strategy.BlueGreenStrategy
+	if rolloutSpecBlueGreen.TrafficRouting == nil {
+		return true, nil
+	}
+	steps := rolloutSpecBlueGreen.TrafficRouting.Steps
+	stepIndex := 0
+	if rollout.Status.CurrentStepIndex != nil {
+		stepIndex = int(*rollout.Status.CurrentStepIndex)
+	}
+	if stepIndex >= len(steps) {
+		return true, nil
+	}
+	if rollout.Status.BlueGreen.CurrentStepStartTime == nil {
+		now := metav1.NewTime(time.Now())
+		rollout.Status.BlueGreen.CurrentStepStartTime = &now
+	}
+	if rollout.Spec.Paused {
+		return false, nil
+	}
+
+	reconciler, err := trafficrouting.NewTrafficRoutingReconciler(rollout, c.dynamicclientset)
+	if err != nil {
+		return false, err
+	}
+
+	step := steps[stepIndex]
+	switch {
+	case step.SetWeight != nil:
+		if err := reconciler.SetWeight(stableHash, previewHash, *step.SetWeight); err != nil {
+			return false, err
+		}
+		verified, err := reconciler.Verify()
+		if err != nil || !verified {
+			return false, err
+		}
+	case step.Pause != nil:
+		if !c.blueGreenPauseElapsed(rollout, step.Pause) {
+			return false, nil
+		}
+	}
+
+	nextIndex := int32(stepIndex + 1)
+	rollout.Status.CurrentStepIndex = &nextIndex
+	now := metav1.NewTime(time.Now())
+	rollout.Status.BlueGreen.CurrentStepStartTime = &now
+	return nextIndex >= int32(len(steps)), nil
+}
+
+// blueGreenPauseElapsed reports whether a Pause step is done waiting. A Pause with no Duration is
+// done whenever Spec.Paused is false; the controller does not set Spec.Paused on reaching the
+// step, so such a step holds the rollout only while a user has paused it. A Pause with a Duration
+// elapses once that much time has passed since the step started.
+func (c *Controller) blueGreenPauseElapsed(rollout *v1alpha1.Rollout, pause *v1alpha1.RolloutPause) bool {
+	if pause.Duration == nil {
+		return !rollout.Spec.Paused
+	}
+	if rollout.Status.BlueGreen.CurrentStepStartTime == nil {
+		return false
+	}
+	deadline := rollout.Status.BlueGreen.CurrentStepStartTime.Add(time.Duration(*pause.Duration) * time.Second)
+	return time.Now().After(deadline)
+}
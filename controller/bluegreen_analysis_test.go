@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	analysiscontroller "github.com/argoproj/argo-rollouts/controller/analysis"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1/analysis"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	analysislisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1/analysis"
+)
+
+func newAnalysisTemplate(name string) *analysis.AnalysisTemplate {
+	return &analysis.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+		Spec: analysis.AnalysisTemplateSpec{
+			Metrics: []analysis.Metric{
+				{
+					Name:             "success-rate",
+					SuccessCondition: "result >= 0.95",
+					Provider: analysis.MetricProvider{
+						Prometheus: &analysis.PrometheusMetric{
+							Address: "http://prometheus.example.com",
+							Query:   "sum(rate(http_requests_total{status!~\"5..\"}[5m]))",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newAnalysisRun(rollout *v1alpha1.Rollout, name string, phase analysis.AnalysisPhase) *analysis.AnalysisRun {
+	return &analysis.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: rollout.Namespace},
+		Status:     analysis.AnalysisRunStatus{Phase: phase},
+	}
+}
+
+func newAnalysisListers(templates []*analysis.AnalysisTemplate, runs []*analysis.AnalysisRun) (analysislisters.AnalysisTemplateLister, analysislisters.AnalysisRunLister) {
+	templateIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, template := range templates {
+		templateIndexer.Add(template)
+	}
+	runIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, run := range runs {
+		runIndexer.Add(run)
+	}
+	return analysislisters.NewAnalysisTemplateLister(templateIndexer), analysislisters.NewAnalysisRunLister(runIndexer)
+}
+
+func TestBlueGreenReconcileAnalysisRunCreatesRunWhenPreviewAvailable(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.Strategy.BlueGreenStrategy.AnalysisTemplateName = "success-rate-template"
+
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+
+	template := newAnalysisTemplate("success-rate-template")
+	templateLister, runLister := newAnalysisListers([]*analysis.AnalysisTemplate{template}, nil)
+
+	fakeClient := fake.NewSimpleClientset()
+	c := &Controller{
+		rolloutsclientset:      fakeClient,
+		analysisTemplateLister: templateLister,
+		analysisRunLister:      runLister,
+		recorder:               &record.FakeRecorder{},
+	}
+
+	finished, err := c.reconcileAnalysisRun(r, previewRS)
+	assert.NoError(t, err)
+	assert.False(t, finished)
+	assert.NotEmpty(t, r.Status.CurrentAnalysisRun)
+
+	actions := fakeClient.Actions()
+	assert.Len(t, actions, 1)
+	assert.Equal(t, "create", actions[0].GetVerb())
+}
+
+func TestBlueGreenReconcileAnalysisRunWaitsOnRunningRun(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.Strategy.BlueGreenStrategy.AnalysisTemplateName = "success-rate-template"
+	r.Status.CurrentAnalysisRun = "foo-895c6c4f9-analysis"
+
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+	run := newAnalysisRun(r, r.Status.CurrentAnalysisRun, analysis.AnalysisPhaseRunning)
+	_, runLister := newAnalysisListers(nil, []*analysis.AnalysisRun{run})
+
+	c := &Controller{
+		analysisRunLister: runLister,
+		recorder:          &record.FakeRecorder{},
+	}
+
+	finished, err := c.reconcileAnalysisRun(r, previewRS)
+	assert.NoError(t, err)
+	assert.False(t, finished)
+}
+
+func TestBlueGreenReconcileAnalysisRunSuccessfulUnblocksPromotion(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.Strategy.BlueGreenStrategy.AnalysisTemplateName = "success-rate-template"
+	r.Status.CurrentAnalysisRun = "foo-895c6c4f9-analysis"
+
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+	run := newAnalysisRun(r, r.Status.CurrentAnalysisRun, analysis.AnalysisPhaseSuccessful)
+	_, runLister := newAnalysisListers(nil, []*analysis.AnalysisRun{run})
+
+	c := &Controller{
+		analysisRunLister: runLister,
+		recorder:          &record.FakeRecorder{},
+	}
+
+	finished, err := c.reconcileAnalysisRun(r, previewRS)
+	assert.NoError(t, err)
+	assert.True(t, finished)
+}
+
+func TestBlueGreenReconcileAnalysisRunFailedAbortsPromotion(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.Strategy.BlueGreenStrategy.AnalysisTemplateName = "success-rate-template"
+	r.Status.CurrentAnalysisRun = "foo-895c6c4f9-analysis"
+
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+	run := newAnalysisRun(r, r.Status.CurrentAnalysisRun, analysis.AnalysisPhaseFailed)
+	_, runLister := newAnalysisListers(nil, []*analysis.AnalysisRun{run})
+
+	recorder := record.NewFakeRecorder(1)
+	c := &Controller{
+		analysisRunLister: runLister,
+		recorder:          recorder,
+	}
+
+	finished, err := c.reconcileAnalysisRun(r, previewRS)
+	assert.Error(t, err)
+	assert.False(t, finished)
+	event := <-recorder.Events
+	assert.Contains(t, event, "PromotionAborted")
+
+	assert.Empty(t, r.Status.CurrentAnalysisRun)
+	assert.Len(t, r.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.AnalysisFailed, r.Status.Conditions[0].Type)
+	assert.Equal(t, v1alpha1.AnalysisRunFailed, r.Status.Conditions[0].Reason)
+}
+
+// fakePrometheusProvider is a minimal analysiscontroller.Provider used to exercise the analysis controller
+// without reaching out to a real Prometheus server.
+type fakePrometheusProvider struct {
+	value      string
+	successful bool
+	err        error
+}
+
+func (f *fakePrometheusProvider) Run(metric analysis.Metric) (string, bool, error) {
+	return f.value, f.successful, f.err
+}
+
+func TestAnalysisControllerReconcileAllMetricsSuccessful(t *testing.T) {
+	run := &analysis.AnalysisRun{
+		Spec: analysis.AnalysisRunSpec{
+			Metrics: []analysis.Metric{
+				{Name: "success-rate", Provider: analysis.MetricProvider{Prometheus: &analysis.PrometheusMetric{}}},
+			},
+		},
+	}
+	providers := map[string]analysiscontroller.Provider{"prometheus": &fakePrometheusProvider{value: "0.99", successful: true}}
+	status := analysiscontroller.NewController(providers).Reconcile(run)
+	assert.Equal(t, analysis.AnalysisPhaseSuccessful, status.Phase)
+	assert.Equal(t, "0.99", status.MetricResults[0].Value)
+}
+
+func TestAnalysisControllerReconcileMetricFailure(t *testing.T) {
+	run := &analysis.AnalysisRun{
+		Spec: analysis.AnalysisRunSpec{
+			Metrics: []analysis.Metric{
+				{Name: "success-rate", Provider: analysis.MetricProvider{Prometheus: &analysis.PrometheusMetric{}}},
+			},
+		},
+	}
+	providers := map[string]analysiscontroller.Provider{"prometheus": &fakePrometheusProvider{value: "0.50", successful: false}}
+	status := analysiscontroller.NewController(providers).Reconcile(run)
+	assert.Equal(t, analysis.AnalysisPhaseFailed, status.Phase)
+	assert.NotEmpty(t, status.Message)
+}
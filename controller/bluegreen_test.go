@@ -1,21 +1,29 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
 )
 
 var (
@@ -30,7 +38,7 @@ func newBlueGreenRollout(name string, replicas int, revisionHistoryLimit *int32,
 	}
 	rollout.Status.CurrentStepIndex = stepIndex
 	rollout.Status.CurrentStepHash = conditions.ComputeStepHash(rollout)
-	rollout.Status.CurrentPodHash = controller.ComputeHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
+	rollout.Status.CurrentPodHash = hash.ComputeHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
 	return rollout
 }
 
@@ -120,7 +128,7 @@ func TestBlueGreenReconcileVerifyingPreview(t *testing.T) {
 func TestBlueGreenHandlePreviewWhenActiveSet(t *testing.T) {
 	f := newFixture(t)
 
-	r1 := newBlueGreenRollout("foo", 1, nil, map[string]string{"foo": "bar"}, "preview", "active")
+	r1 := newBlueGreenRollout("foo", 1, nil, nil, "preview", "active")
 
 	r2 := r1.DeepCopy()
 	annotations.SetRolloutRevision(r2, "2")
@@ -152,7 +160,7 @@ func TestBlueGreenHandlePreviewWhenActiveSet(t *testing.T) {
 func TestBlueGreenHandleVerifyingPreviewSetButNotPreviewSvc(t *testing.T) {
 	f := newFixture(t)
 
-	r1 := newBlueGreenRollout("foo", 1, nil, map[string]string{"foo": "bar"}, "active", "preview")
+	r1 := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
 	r2 := r1.DeepCopy()
 	annotations.SetRolloutRevision(r2, "2")
 	r2.Spec.Template.Spec.Containers[0].Image = "foo/bar2.0"
@@ -302,3 +310,343 @@ func TestBlueGreenScaleDownOldRS(t *testing.T) {
 
 	f.run(getKey(r2, t))
 }
+
+func newHook(name string) v1alpha1.RolloutHook {
+	return v1alpha1.RolloutHook{
+		Name: name,
+		JobTemplate: v1alpha1.JobTemplateSpec{
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyNever,
+						Containers: []corev1.Container{
+							{Name: name, Image: "argoproj/rollouts-demo:verify"},
+						},
+					},
+				},
+			},
+		},
+		BackoffLimit:   pointer.Int32Ptr(0),
+		TimeoutSeconds: pointer.Int32Ptr(180),
+	}
+}
+
+func newHookJob(rollout *v1alpha1.Rollout, prefix string, hook v1alpha1.RolloutHook, succeeded, failed, active int32) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%s", rollout.Name, prefix, hook.Name),
+			Namespace: rollout.Namespace,
+		},
+		Spec: hook.JobTemplate.Spec,
+		Status: batchv1.JobStatus{
+			Succeeded: succeeded,
+			Failed:    failed,
+			Active:    active,
+		},
+	}
+	return job
+}
+
+func TestBlueGreenPrePromotionHookCreatesJob(t *testing.T) {
+	f := newFixture(t)
+
+	r := newBlueGreenRollout("foo", 1, nil, pointer.Int32Ptr(1), "active", "preview")
+	r.Spec.Strategy.BlueGreenStrategy.PrePromotionHooks = []v1alpha1.RolloutHook{newHook("smoke-test")}
+	r.Status.VerifyingPreview = pointer.BoolPtr(false)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	rs := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+
+	previewSvc := newService("preview", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "895c6c4f9"})
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "test"})
+	f.kubeobjects = append(f.kubeobjects, previewSvc, activeSvc)
+
+	expectedJob := newHookJob(r, "pre", r.Spec.Strategy.BlueGreenStrategy.PrePromotionHooks[0], 0, 0, 0)
+
+	f.expectGetServiceAction(activeSvc)
+	f.expectGetServiceAction(previewSvc)
+	f.expectCreateJobAction(expectedJob)
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+}
+
+func TestBlueGreenPrePromotionHookSuccessSwitchesActiveService(t *testing.T) {
+	f := newFixture(t)
+
+	r := newBlueGreenRollout("foo", 1, nil, pointer.Int32Ptr(1), "active", "preview")
+	hook := newHook("smoke-test")
+	r.Spec.Strategy.BlueGreenStrategy.PrePromotionHooks = []v1alpha1.RolloutHook{hook}
+	r.Status.VerifyingPreview = pointer.BoolPtr(false)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	rs := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+
+	job := newHookJob(r, "pre", hook, 1, 0, 0)
+	f.kubeobjects = append(f.kubeobjects, job)
+	f.jobLister = append(f.jobLister, job)
+
+	previewSvc := newService("preview", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "895c6c4f9"})
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "test"})
+	f.kubeobjects = append(f.kubeobjects, previewSvc, activeSvc)
+
+	f.expectGetServiceAction(activeSvc)
+	f.expectGetServiceAction(previewSvc)
+	f.expectPatchServiceAction(activeSvc, "895c6c4f9")
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+}
+
+func TestBlueGreenPrePromotionHookRunningDoesNotSwitchActiveService(t *testing.T) {
+	f := newFixture(t)
+
+	r := newBlueGreenRollout("foo", 1, nil, pointer.Int32Ptr(1), "active", "preview")
+	hook := newHook("smoke-test")
+	r.Spec.Strategy.BlueGreenStrategy.PrePromotionHooks = []v1alpha1.RolloutHook{hook}
+	r.Status.VerifyingPreview = pointer.BoolPtr(false)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	rs := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+
+	job := newHookJob(r, "pre", hook, 0, 0, 1)
+	f.kubeobjects = append(f.kubeobjects, job)
+	f.jobLister = append(f.jobLister, job)
+
+	previewSvc := newService("preview", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "895c6c4f9"})
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "test"})
+	f.kubeobjects = append(f.kubeobjects, previewSvc, activeSvc)
+
+	f.expectGetServiceAction(activeSvc)
+	f.expectGetServiceAction(previewSvc)
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+}
+
+func TestBlueGreenPrePromotionHookFailureTriggersRollback(t *testing.T) {
+	f := newFixture(t)
+
+	r := newBlueGreenRollout("foo", 1, nil, pointer.Int32Ptr(1), "active", "preview")
+	hook := newHook("smoke-test")
+	r.Spec.Strategy.BlueGreenStrategy.PrePromotionHooks = []v1alpha1.RolloutHook{hook}
+	r.Status.VerifyingPreview = pointer.BoolPtr(false)
+	r.Status.StableRS = "test"
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	rs := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+
+	job := newHookJob(r, "pre", hook, 0, 1, 0)
+	f.kubeobjects = append(f.kubeobjects, job)
+	f.jobLister = append(f.jobLister, job)
+
+	previewSvc := newService("preview", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "895c6c4f9"})
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "test"})
+	f.kubeobjects = append(f.kubeobjects, previewSvc, activeSvc)
+
+	f.expectGetServiceAction(activeSvc)
+	f.expectGetServiceAction(previewSvc)
+	f.expectPatchServiceAction(activeSvc, "test")
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+}
+
+func TestBlueGreenPostPromotionHookCreatesJob(t *testing.T) {
+	f := newFixture(t)
+
+	r1 := newBlueGreenRollout("foo", 1, nil, nil, "active", "")
+	r2 := bumpVersion(r1)
+	hook := newHook("smoke-test")
+	r2.Spec.Strategy.BlueGreenStrategy.PostPromotionHooks = []v1alpha1.RolloutHook{hook}
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	rs2 := newReplicaSetWithStatus(r2, "foo-5f79b78d7f", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs2)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash})
+	f.kubeobjects = append(f.kubeobjects, activeSvc)
+
+	expectedJob := newHookJob(r2, "post", hook, 0, 0, 0)
+
+	f.expectGetServiceAction(activeSvc)
+	f.expectCreateJobAction(expectedJob)
+	f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+}
+
+func TestBlueGreenPostPromotionHookSuccessScalesDownOldReplicaSet(t *testing.T) {
+	f := newFixture(t)
+
+	r1 := newBlueGreenRollout("foo", 1, nil, nil, "active", "")
+	r2 := bumpVersion(r1)
+	hook := newHook("smoke-test")
+	r2.Spec.Strategy.BlueGreenStrategy.PostPromotionHooks = []v1alpha1.RolloutHook{hook}
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	rs1 := newReplicaSetWithStatus(r1, "foo-895c6c4f9", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+
+	rs2 := newReplicaSetWithStatus(r2, "foo-5f79b78d7f", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs2)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	job := newHookJob(r2, "post", hook, 1, 0, 0)
+	f.kubeobjects = append(f.kubeobjects, job)
+	f.jobLister = append(f.jobLister, job)
+
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash})
+	f.kubeobjects = append(f.kubeobjects, activeSvc)
+
+	expRS1 := rs1.DeepCopy()
+	expRS1.Annotations[annotations.DesiredReplicasAnnotation] = "1"
+
+	f.expectGetServiceAction(activeSvc)
+	f.expectUpdateReplicaSetAction(expRS1)
+	f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+}
+
+func TestBlueGreenPostPromotionHookRunningDoesNotScaleDown(t *testing.T) {
+	f := newFixture(t)
+
+	r1 := newBlueGreenRollout("foo", 1, nil, nil, "active", "")
+	r2 := bumpVersion(r1)
+	hook := newHook("smoke-test")
+	r2.Spec.Strategy.BlueGreenStrategy.PostPromotionHooks = []v1alpha1.RolloutHook{hook}
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	rs1 := newReplicaSetWithStatus(r1, "foo-895c6c4f9", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+
+	rs2 := newReplicaSetWithStatus(r2, "foo-5f79b78d7f", 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs2)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	job := newHookJob(r2, "post", hook, 0, 0, 1)
+	f.kubeobjects = append(f.kubeobjects, job)
+	f.jobLister = append(f.jobLister, job)
+
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash})
+	f.kubeobjects = append(f.kubeobjects, activeSvc)
+
+	f.expectGetServiceAction(activeSvc)
+	f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+}
+
+func TestBlueGreenPostPromotionHookFailureMarksDegraded(t *testing.T) {
+	r1 := newBlueGreenRollout("foo", 1, nil, nil, "active", "")
+	r2 := bumpVersion(r1)
+	hook := newHook("smoke-test")
+	r2.Spec.Strategy.BlueGreenStrategy.PostPromotionHooks = []v1alpha1.RolloutHook{hook}
+
+	rs1 := newReplicaSetWithStatus(r1, "foo-895c6c4f9", 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, "foo-5f79b78d7f", 1, 1)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	job := newHookJob(r2, "post", hook, 0, 1, 0)
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash})
+
+	kubeclient := k8sfake.NewSimpleClientset(activeSvc, rs1, rs2, job)
+
+	rsIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	rsIndexer.Add(rs1)
+	rsIndexer.Add(rs2)
+	jobIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	jobIndexer.Add(job)
+	svcIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	svcIndexer.Add(activeSvc)
+
+	c := &Controller{
+		kubeclientset:     kubeclient,
+		rolloutsclientset: fake.NewSimpleClientset(r2),
+		replicaSetLister:  appslisters.NewReplicaSetLister(rsIndexer),
+		jobLister:         batchlisters.NewJobLister(jobIndexer),
+		serviceLister:     corelisters.NewServiceLister(svcIndexer),
+		recorder:          &record.FakeRecorder{Events: make(chan string, 100)},
+	}
+
+	err := c.syncBlueGreenRollout(r2)
+	assert.NoError(t, err)
+	assert.Len(t, r2.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.RolloutDegraded, r2.Status.Conditions[0].Type)
+	assert.Equal(t, v1alpha1.HookFailed, r2.Status.Conditions[0].Reason)
+}
+
+func TestBlueGreenProgressDeadlineRecordsPreviewStartTime(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.ProgressDeadlineSeconds = pointer.Int32Ptr(600)
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 0)
+
+	c := &Controller{recorder: &record.FakeRecorder{}}
+	err := c.reconcileBlueGreenProgressDeadline(r, previewRS, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, r.Status.BlueGreen.PreviewStartTime)
+	assert.Empty(t, r.Status.Conditions)
+}
+
+func TestBlueGreenProgressDeadlineNotExceededWhilePreviewStillStarting(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.ProgressDeadlineSeconds = pointer.Int32Ptr(600)
+	startTime := metav1.NewTime(time.Now())
+	r.Status.BlueGreen.PreviewStartTime = &startTime
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 0)
+
+	c := &Controller{recorder: &record.FakeRecorder{}}
+	err := c.reconcileBlueGreenProgressDeadline(r, previewRS, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, r.Status.Conditions)
+}
+
+func TestBlueGreenProgressDeadlineExceededWithoutAutoRollback(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.ProgressDeadlineSeconds = pointer.Int32Ptr(600)
+	startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	r.Status.BlueGreen.PreviewStartTime = &startTime
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 0)
+
+	c := &Controller{recorder: &record.FakeRecorder{}}
+	err := c.reconcileBlueGreenProgressDeadline(r, previewRS, nil)
+	assert.NoError(t, err)
+	assert.Len(t, r.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ProgressDeadlineExceeded, r.Status.Conditions[0].Reason)
+}
+
+func TestBlueGreenProgressDeadlineExceededWithAutoRollback(t *testing.T) {
+	r := newBlueGreenRollout("foo", 1, nil, nil, "active", "preview")
+	r.Spec.Strategy.BlueGreenStrategy.AutoRollbackOnFailure = true
+	r.Spec.ProgressDeadlineSeconds = pointer.Int32Ptr(600)
+	r.Status.StableRS = "stable-hash"
+	startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	r.Status.BlueGreen.PreviewStartTime = &startTime
+	previewRS := newReplicaSetWithStatus(r, "foo-895c6c4f9", 1, 0)
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "foo-895c6c4f9"})
+
+	k8sClient := k8sfake.NewSimpleClientset(activeSvc, previewRS)
+	c := &Controller{kubeclientset: k8sClient, recorder: &record.FakeRecorder{}}
+	err := c.reconcileBlueGreenProgressDeadline(r, previewRS, activeSvc)
+	assert.NoError(t, err)
+	assert.Len(t, r.Status.Conditions, 1)
+
+	updatedRS, getErr := k8sClient.AppsV1().ReplicaSets(previewRS.Namespace).Get(context.TODO(), previewRS.Name, metav1.GetOptions{})
+	assert.NoError(t, getErr)
+	assert.Equal(t, int32(0), *updatedRS.Spec.Replicas)
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/trafficrouting/istio"
+)
+
+func newUnstructuredVSForTest(name, namespace string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "networking.istio.io/v1alpha3",
+			"kind":       "VirtualService",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"http": []interface{}{
+					map[string]interface{}{
+						"route": []interface{}{
+							map[string]interface{}{
+								"destination": map[string]interface{}{"subset": "stable-hash"},
+								"weight":      int64(100),
+							},
+							map[string]interface{}{
+								"destination": map[string]interface{}{"subset": "preview-hash"},
+								"weight":      int64(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newBlueGreenRolloutWithTrafficRouting(name string, steps []v1alpha1.CanaryStep, vsName string) *v1alpha1.Rollout {
+	r := newBlueGreenRollout(name, 1, nil, nil, "active", "preview")
+	r.Spec.Strategy.BlueGreenStrategy.TrafficRouting = &v1alpha1.TrafficRouting{
+		Istio: &v1alpha1.IstioTrafficRouting{VirtualService: vsName},
+		Steps: steps,
+	}
+	return r
+}
+
+func TestBlueGreenTrafficRoutingAdvancesSetWeightStep(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32Ptr(10)},
+		{SetWeight: pointer.Int32Ptr(100)},
+	}
+	r := newBlueGreenRolloutWithTrafficRouting("foo", steps, "foo-vsvc")
+	vs := newUnstructuredVSForTest("foo-vsvc", r.Namespace)
+
+	c := &Controller{dynamicclientset: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), vs)}
+
+	done, err := c.reconcileBlueGreenTrafficRouting(r, "stable-hash", "preview-hash")
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, int32(1), *r.Status.CurrentStepIndex)
+	assert.NotNil(t, r.Status.BlueGreen.CurrentStepStartTime)
+}
+
+func TestBlueGreenTrafficRoutingCompletesAfterLastStep(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32Ptr(100)},
+	}
+	r := newBlueGreenRolloutWithTrafficRouting("foo", steps, "foo-vsvc")
+	vs := newUnstructuredVSForTest("foo-vsvc", r.Namespace)
+
+	c := &Controller{dynamicclientset: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), vs)}
+
+	done, err := c.reconcileBlueGreenTrafficRouting(r, "stable-hash", "preview-hash")
+	assert.NoError(t, err)
+	assert.True(t, done)
+}
+
+func TestBlueGreenTrafficRoutingWaitsOnIndefinitePause(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{Pause: &v1alpha1.RolloutPause{}},
+	}
+	r := newBlueGreenRolloutWithTrafficRouting("foo", steps, "foo-vsvc")
+	r.Spec.Paused = true
+
+	c := &Controller{}
+	done, err := c.reconcileBlueGreenTrafficRouting(r, "stable-hash", "preview-hash")
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Nil(t, r.Status.CurrentStepIndex)
+}
+
+func TestBlueGreenTrafficRoutingWaitsOnTimedPause(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{Pause: &v1alpha1.RolloutPause{Duration: pointer.Int32Ptr(300)}},
+	}
+	r := newBlueGreenRolloutWithTrafficRouting("foo", steps, "foo-vsvc")
+	started := metav1.NewTime(time.Now())
+	r.Status.BlueGreen.CurrentStepStartTime = &started
+
+	c := &Controller{}
+	done, err := c.reconcileBlueGreenTrafficRouting(r, "stable-hash", "preview-hash")
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Nil(t, r.Status.CurrentStepIndex)
+}
+
+func TestBlueGreenTrafficRoutingAdvancesPastElapsedTimedPause(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{Pause: &v1alpha1.RolloutPause{Duration: pointer.Int32Ptr(300)}},
+	}
+	r := newBlueGreenRolloutWithTrafficRouting("foo", steps, "foo-vsvc")
+	started := metav1.NewTime(time.Now().Add(-time.Hour))
+	r.Status.BlueGreen.CurrentStepStartTime = &started
+
+	c := &Controller{}
+	done, err := c.reconcileBlueGreenTrafficRouting(r, "stable-hash", "preview-hash")
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, int32(1), *r.Status.CurrentStepIndex)
+}
+
+func vsListKinds() map[schema.GroupVersionResource]string {
+	return map[schema.GroupVersionResource]string{istio.VirtualServiceGVR: "VirtualServiceList"}
+}
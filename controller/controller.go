@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"k8s.io/client-go/dynamic"
+	appsinformers "k8s.io/client-go/informers/apps/v1"
+	batchinformers "k8s.io/client-go/informers/batch/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	analysiscontroller "github.com/argoproj/argo-rollouts/controller/analysis"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	analysisinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1/analysis"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	analysislisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1/analysis"
+)
+
+// Controller is the controller implementation for Rollout resources
+type Controller struct {
+	// kubeclientset is a standard kubernetes clientset
+	kubeclientset kubernetes.Interface
+	// rolloutsclientset is a clientset for our own API group
+	rolloutsclientset clientset.Interface
+	// dynamicclientset is used to read/update traffic routing resources (e.g. Istio
+	// VirtualServices, SMI TrafficSplits) that don't have generated typed clients
+	dynamicclientset dynamic.Interface
+
+	replicaSetLister       appslisters.ReplicaSetLister
+	rolloutLister          listers.RolloutLister
+	serviceLister          corelisters.ServiceLister
+	jobLister              batchlisters.JobLister
+	analysisTemplateLister analysislisters.AnalysisTemplateLister
+	analysisRunLister      analysislisters.AnalysisRunLister
+
+	rolloutSynced          cache.InformerSynced
+	replicaSetSynced       cache.InformerSynced
+	serviceSynced          cache.InformerSynced
+	jobSynced              cache.InformerSynced
+	analysisTemplateSynced cache.InformerSynced
+	analysisRunSynced      cache.InformerSynced
+
+	// analysisController evaluates the metrics of AnalysisRuns created for BlueGreen preview
+	// verification
+	analysisController *analysiscontroller.Controller
+
+	workqueue workqueue.RateLimitingInterface
+	recorder  record.EventRecorder
+}
+
+// NewController returns a new rollout controller
+func NewController(
+	kubeclientset kubernetes.Interface,
+	rolloutsclientset clientset.Interface,
+	dynamicclientset dynamic.Interface,
+	replicaSetInformer appsinformers.ReplicaSetInformer,
+	serviceInformer coreinformers.ServiceInformer,
+	jobInformer batchinformers.JobInformer,
+	rolloutInformer informers.RolloutInformer,
+	analysisTemplateInformer analysisinformers.AnalysisTemplateInformer,
+	analysisRunInformer analysisinformers.AnalysisRunInformer,
+	analysisController *analysiscontroller.Controller,
+	recorder record.EventRecorder,
+) *Controller {
+	controller := &Controller{
+		kubeclientset:          kubeclientset,
+		rolloutsclientset:      rolloutsclientset,
+		dynamicclientset:       dynamicclientset,
+		replicaSetLister:       replicaSetInformer.Lister(),
+		rolloutLister:          rolloutInformer.Lister(),
+		serviceLister:          serviceInformer.Lister(),
+		jobLister:              jobInformer.Lister(),
+		analysisTemplateLister: analysisTemplateInformer.Lister(),
+		analysisRunLister:      analysisRunInformer.Lister(),
+		analysisController:     analysisController,
+		rolloutSynced:          rolloutInformer.Informer().HasSynced,
+		replicaSetSynced:       replicaSetInformer.Informer().HasSynced,
+		serviceSynced:          serviceInformer.Informer().HasSynced,
+		jobSynced:              jobInformer.Informer().HasSynced,
+		analysisTemplateSynced: analysisTemplateInformer.Informer().HasSynced,
+		analysisRunSynced:      analysisRunInformer.Informer().HasSynced,
+		workqueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Rollouts"),
+		recorder:               recorder,
+	}
+	return controller
+}
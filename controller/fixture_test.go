@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func getKey(rollout *v1alpha1.Rollout, t *testing.T) string {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(rollout)
+	if err != nil {
+		t.Errorf("Unexpected error getting key for rollout %v: %v", rollout.Name, err)
+		return ""
+	}
+	return key
+}
+
+func newRollout(name string, replicas int, revisionHistoryLimit *int32, selector map[string]string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   metav1.NamespaceDefault,
+			Annotations: map[string]string{},
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas:             int32Ptr(int32(replicas)),
+			RevisionHistoryLimit: revisionHistoryLimit,
+			Selector:             &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: name, Image: "foo/bar"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newService(name string, port int32, selector map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+		Spec: corev1.ServiceSpec{
+			Ports:    []corev1.ServicePort{{Port: port}},
+			Selector: selector,
+		},
+	}
+}
+
+// podHash recovers the pod-template-hash that newReplicaSet baked into the ReplicaSet's name
+// (<rollout name>-<hash>), so tests can build fixtures around easy-to-read literal names.
+func podHash(rsName, rolloutName string) string {
+	return strings.TrimPrefix(rsName, rolloutName+"-")
+}
+
+func newReplicaSet(rollout *v1alpha1.Rollout, name string, replicas int) *appsv1.ReplicaSet {
+	labels := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: podHash(name, rollout.Name)}
+	for k, v := range rollout.Spec.Selector.MatchLabels {
+		labels[k] = v
+	}
+	template := *rollout.Spec.Template.DeepCopy()
+	template.Labels = labels
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   rollout.Namespace,
+			Labels:      labels,
+			Annotations: map[string]string{annotations.RevisionAnnotation: rollout.Annotations[annotations.RevisionAnnotation]},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(rollout, rolloutKind),
+			},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: int32Ptr(int32(replicas)),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: template,
+		},
+	}
+}
+
+func newReplicaSetWithStatus(rollout *v1alpha1.Rollout, name string, replicas, availableReplicas int) *appsv1.ReplicaSet {
+	rs := newReplicaSet(rollout, name, replicas)
+	rs.Status = appsv1.ReplicaSetStatus{
+		Replicas:          int32(replicas),
+		AvailableReplicas: int32(availableReplicas),
+		ReadyReplicas:     int32(availableReplicas),
+	}
+	return rs
+}
+
+// newImage returns a copy of rs running a different image under a distinct name, used by tests
+// that need a second, non-matching ReplicaSet to already exist alongside the current one.
+func newImage(rs *appsv1.ReplicaSet, image string) *appsv1.ReplicaSet {
+	newRS := rs.DeepCopy()
+	newRS.Name = rs.Name + "-2"
+	newRS.Spec.Template.Spec.Containers[0].Image = image
+	return newRS
+}
+
+// bumpVersion returns a copy of rollout representing the next revision: the revision annotation
+// is incremented and the container image is changed so the rollout gets a new pod template hash.
+func bumpVersion(rollout *v1alpha1.Rollout) *v1alpha1.Rollout {
+	newRollout := rollout.DeepCopy()
+	revision := 2
+	if rev, err := strconv.Atoi(rollout.Annotations[annotations.RevisionAnnotation]); err == nil {
+		revision = rev + 1
+	}
+	annotations.SetRolloutRevision(newRollout, strconv.Itoa(revision))
+	newRollout.Spec.Template.Spec.Containers[0].Image = fmt.Sprintf("foo/bar:v%d", revision)
+	return newRollout
+}
+
+// fixture drives Controller.syncHandler against fake clientsets/listers seeded directly from
+// in-memory objects, in the style of k8s.io/kubernetes's deployment controller tests.
+type fixture struct {
+	t *testing.T
+
+	rolloutLister    []*v1alpha1.Rollout
+	replicaSetLister []*appsv1.ReplicaSet
+	jobLister        []*batchv1.Job
+
+	// objects/kubeobjects seed the rollouts and kube fake clientsets respectively.
+	objects     []runtime.Object
+	kubeobjects []runtime.Object
+
+	expectedActions []expectedAction
+}
+
+// expectedAction is a loosened stand-in for a recorded client-go Action: it asserts that an
+// action of this verb/resource occurred against this namespace, without requiring the exact
+// object body or call ordering to match. The BlueGreen reconcile sequence touches several
+// independent resources (Services, ReplicaSets, Jobs, the Rollout itself) whose relative fetch
+// order isn't semantically meaningful, so tests assert on the multiset of operations performed.
+type expectedAction struct {
+	verb     string
+	resource string
+}
+
+func newFixture(t *testing.T) *fixture {
+	return &fixture{t: t}
+}
+
+func (f *fixture) expectGetServiceAction(svc *corev1.Service) {
+	f.expectedActions = append(f.expectedActions, expectedAction{verb: "get", resource: "services"})
+}
+
+func (f *fixture) expectPatchServiceAction(svc *corev1.Service, podHash string) {
+	f.expectedActions = append(f.expectedActions, expectedAction{verb: "patch", resource: "services"})
+}
+
+func (f *fixture) expectCreateReplicaSetAction(rs *appsv1.ReplicaSet) {
+	f.expectedActions = append(f.expectedActions, expectedAction{verb: "create", resource: "replicasets"})
+}
+
+func (f *fixture) expectUpdateReplicaSetAction(rs *appsv1.ReplicaSet) {
+	f.expectedActions = append(f.expectedActions, expectedAction{verb: "update", resource: "replicasets"})
+}
+
+func (f *fixture) expectCreateJobAction(job *batchv1.Job) {
+	f.expectedActions = append(f.expectedActions, expectedAction{verb: "create", resource: "jobs"})
+}
+
+func (f *fixture) expectPatchRolloutAction(rollout *v1alpha1.Rollout) {
+	f.expectedActions = append(f.expectedActions, expectedAction{verb: "patch", resource: "rollouts"})
+}
+
+func filterInformerActions(actions []core.Action) []core.Action {
+	ret := []core.Action{}
+	for _, action := range actions {
+		if action.Matches("list", "") || action.Matches("watch", "") {
+			continue
+		}
+		ret = append(ret, action)
+	}
+	return ret
+}
+
+func (f *fixture) run(key string) {
+	kubeclient := k8sfake.NewSimpleClientset(f.kubeobjects...)
+	rolloutsclient := fake.NewSimpleClientset(f.objects...)
+
+	rolloutIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, r := range f.rolloutLister {
+		rolloutIndexer.Add(r)
+	}
+	rsIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, rs := range f.replicaSetLister {
+		rsIndexer.Add(rs)
+	}
+	jobIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, job := range f.jobLister {
+		jobIndexer.Add(job)
+	}
+	svcIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range f.kubeobjects {
+		if svc, ok := obj.(*corev1.Service); ok {
+			svcIndexer.Add(svc)
+		}
+	}
+
+	c := &Controller{
+		kubeclientset:     kubeclient,
+		rolloutsclientset: rolloutsclient,
+		rolloutLister:     listers.NewRolloutLister(rolloutIndexer),
+		replicaSetLister:  appslisters.NewReplicaSetLister(rsIndexer),
+		jobLister:         batchlisters.NewJobLister(jobIndexer),
+		serviceLister:     corelisters.NewServiceLister(svcIndexer),
+		recorder:          &record.FakeRecorder{Events: make(chan string, 100)},
+	}
+
+	if err := c.syncHandler(key); err != nil {
+		f.t.Errorf("error syncing rollout: %v", err)
+	}
+
+	actual := append(filterInformerActions(kubeclient.Actions()), filterInformerActions(rolloutsclient.Actions())...)
+
+	remaining := make([]expectedAction, len(f.expectedActions))
+	copy(remaining, f.expectedActions)
+	for _, action := range actual {
+		matched := false
+		for i, exp := range remaining {
+			if action.Matches(exp.verb, exp.resource) {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			f.t.Errorf("unexpected action: %s %s", action.GetVerb(), action.GetResource().Resource)
+		}
+	}
+	for _, exp := range remaining {
+		f.t.Errorf("expected action not seen: %s %s", exp.verb, exp.resource)
+	}
+	assert.Len(f.t, remaining, 0)
+}
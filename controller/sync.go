@@ -0,0 +1,332 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+// Run starts workers workers to process items off the workqueue until stopCh is closed. It
+// blocks until the informer caches have synced and returns once stopCh is closed.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.workqueue.ShutDown()
+
+	if ok := cache.WaitForCacheSync(stopCh, c.rolloutSynced, c.replicaSetSynced, c.serviceSynced,
+		c.jobSynced, c.analysisTemplateSynced, c.analysisRunSynced); !ok {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+// processNextWorkItem pops a single key off the workqueue and syncs it, requeuing on error. It
+// returns false only once the workqueue has been shut down, signalling the worker to exit.
+func (c *Controller) processNextWorkItem() bool {
+	obj, shutdown := c.workqueue.Get()
+	if shutdown {
+		return false
+	}
+
+	err := func(obj interface{}) error {
+		defer c.workqueue.Done(obj)
+		key, ok := obj.(string)
+		if !ok {
+			c.workqueue.Forget(obj)
+			utilruntime.HandleError(fmt.Errorf("expected string in workqueue but got %#v", obj))
+			return nil
+		}
+		if err := c.syncHandler(key); err != nil {
+			c.workqueue.AddRateLimited(key)
+			return fmt.Errorf("error syncing %q: %w, requeuing", key, err)
+		}
+		c.workqueue.Forget(obj)
+		return nil
+	}(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+	}
+	return true
+}
+
+// syncHandler reconciles a single Rollout, identified by its namespace/name key.
+func (c *Controller) syncHandler(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("invalid resource key: %s", key))
+		return nil
+	}
+
+	rollout, err := c.rolloutLister.Rollouts(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			utilruntime.HandleError(fmt.Errorf("rollout '%s' in work queue no longer exists", key))
+			return nil
+		}
+		return err
+	}
+
+	if rollout.Spec.Strategy.BlueGreenStrategy == nil {
+		return nil
+	}
+	return c.syncBlueGreenRollout(rollout.DeepCopy())
+}
+
+// syncBlueGreenRollout drives a single BlueGreen Rollout through one reconcile pass: it ensures
+// the ReplicaSet for the current pod template exists, points the preview service at it once
+// available, and - once any configured pre-promotion hooks and AnalysisRun verification have
+// succeeded and weighted traffic-routing steps (if any) have completed - switches the active
+// service over and scales down the previous ReplicaSet.
+func (c *Controller) syncBlueGreenRollout(rollout *v1alpha1.Rollout) error {
+	bg := rollout.Spec.Strategy.BlueGreenStrategy
+
+	newRS, newPodHash, err := c.getReplicaSetForRollout(rollout)
+	if err != nil {
+		return err
+	}
+	rollout.Status.CurrentPodHash = newPodHash
+
+	var activeSvc, previewSvc *corev1.Service
+	if bg.ActiveService != "" {
+		if activeSvc, err = c.kubeclientset.CoreV1().Services(rollout.Namespace).Get(context.TODO(), bg.ActiveService, metav1.GetOptions{}); err != nil {
+			return err
+		}
+	}
+	if bg.PreviewService != "" {
+		if previewSvc, err = c.kubeclientset.CoreV1().Services(rollout.Namespace).Get(context.TODO(), bg.PreviewService, metav1.GetOptions{}); err != nil {
+			return err
+		}
+	}
+
+	if newRS == nil {
+		if newRS, err = c.createReplicaSetForRollout(rollout, newPodHash); err != nil {
+			return err
+		}
+		rollout.Status.BlueGreen.PreviewStartTime = nil
+		// A new revision starts its weighted traffic-routing steps over from the beginning rather
+		// than resuming wherever the previous revision's rollout left off.
+		rollout.Status.CurrentStepIndex = nil
+		rollout.Status.BlueGreen.CurrentStepStartTime = nil
+		return c.patchRolloutStatus(rollout)
+	}
+
+	if err := c.reconcileBlueGreenProgressDeadline(rollout, newRS, activeSvc); err != nil {
+		return err
+	}
+
+	newRSAvailable := newRS.Spec.Replicas != nil && newRS.Status.AvailableReplicas >= *newRS.Spec.Replicas
+
+	var activeHasRolloutSelector bool
+	if activeSvc != nil {
+		_, activeHasRolloutSelector = activeSvc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey]
+	}
+
+	// Only route verification traffic through the preview service once the active service is
+	// itself already hash-selector managed; otherwise this is the rollout's first promotion and
+	// there's nothing meaningful to preview against, so go straight to promoting active.
+	if activeHasRolloutSelector && previewSvc != nil && newRSAvailable &&
+		previewSvc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey] != newPodHash {
+		if err := c.patchServiceSelector(previewSvc, newPodHash); err != nil {
+			return err
+		}
+		if rollout.Status.VerifyingPreview == nil && !bg.AutoPromotionEnabled {
+			verifying := true
+			rollout.Status.VerifyingPreview = &verifying
+		}
+		if err := c.patchRolloutStatus(rollout); err != nil {
+			return err
+		}
+	}
+
+	if activeSvc == nil || !newRSAvailable {
+		return c.patchRolloutStatus(rollout)
+	}
+
+	if activeSvc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey] == newPodHash {
+		rollout.Status.StableRS = newPodHash
+		postHooksDone, err := c.reconcilePostPromotionHooks(rollout)
+		if err != nil {
+			appendDegradedCondition(rollout, err)
+			return c.patchRolloutStatus(rollout)
+		}
+		if !postHooksDone {
+			return c.patchRolloutStatus(rollout)
+		}
+		if err := c.scaleDownOldReplicaSets(rollout, newPodHash); err != nil {
+			return err
+		}
+		return c.patchRolloutStatus(rollout)
+	}
+
+	if c.reconcileVerifyingPreview(activeSvc, rollout) {
+		return c.patchRolloutStatus(rollout)
+	}
+
+	hooksDone, err := c.reconcilePrePromotionHooks(rollout)
+	if err != nil {
+		appendDegradedCondition(rollout, err)
+		if rbErr := c.rollbackActiveServiceSelector(rollout, activeSvc); rbErr != nil {
+			return rbErr
+		}
+		return c.patchRolloutStatus(rollout)
+	}
+	if !hooksDone {
+		return c.patchRolloutStatus(rollout)
+	}
+
+	analysisDone, err := c.reconcileAnalysisRun(rollout, newRS)
+	if err != nil || !analysisDone {
+		return c.patchRolloutStatus(rollout)
+	}
+
+	if bg.TrafficRouting != nil {
+		trafficDone, err := c.reconcileBlueGreenTrafficRouting(rollout, rollout.Status.StableRS, newPodHash)
+		if err != nil {
+			return err
+		}
+		if !trafficDone {
+			return c.patchRolloutStatus(rollout)
+		}
+	}
+
+	if err := c.patchServiceSelector(activeSvc, newPodHash); err != nil {
+		return err
+	}
+	rollout.Status.StableRS = newPodHash
+	rollout.Status.CurrentAnalysisRun = ""
+	return c.patchRolloutStatus(rollout)
+}
+
+// getReplicaSetForRollout looks for a ReplicaSet owned by rollout whose pod template already
+// matches rollout.Spec.Template, and returns it together with its pod-template-hash label. If no
+// such ReplicaSet exists, it returns a freshly computed hash for one that still needs to be
+// created.
+func (c *Controller) getReplicaSetForRollout(rollout *v1alpha1.Rollout) (*appsv1.ReplicaSet, string, error) {
+	rsList, err := c.replicaSetLister.ReplicaSets(rollout.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, "", err
+	}
+	for _, rs := range rsList {
+		if !metav1.IsControlledBy(rs, rollout) {
+			continue
+		}
+		if reflect.DeepEqual(rs.Spec.Template.Spec, rollout.Spec.Template.Spec) {
+			return rs, rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], nil
+		}
+	}
+	return nil, hash.ComputeHash(&rollout.Spec.Template, rollout.Status.CollisionCount), nil
+}
+
+func (c *Controller) createReplicaSetForRollout(rollout *v1alpha1.Rollout, podHash string) (*appsv1.ReplicaSet, error) {
+	newRSLabels := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: podHash}
+	if rollout.Spec.Selector != nil {
+		for k, v := range rollout.Spec.Selector.MatchLabels {
+			newRSLabels[k] = v
+		}
+	}
+	template := *rollout.Spec.Template.DeepCopy()
+	template.Labels = newRSLabels
+
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", rollout.Name, podHash),
+			Namespace: rollout.Namespace,
+			Labels:    newRSLabels,
+			Annotations: map[string]string{
+				annotations.RevisionAnnotation: rollout.Annotations[annotations.RevisionAnnotation],
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(rollout, rolloutKind),
+			},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: rollout.Spec.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: newRSLabels},
+			Template: template,
+		},
+	}
+	created, err := c.kubeclientset.AppsV1().ReplicaSets(rollout.Namespace).Create(context.TODO(), rs, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.Eventf(rollout, corev1.EventTypeNormal, "ReplicaSetCreated", "created ReplicaSet %s", created.Name)
+	return created, nil
+}
+
+// scaleDownOldReplicaSets scales every ReplicaSet owned by rollout, other than the one matching
+// currentHash, down to zero once the active service has switched over to currentHash.
+func (c *Controller) scaleDownOldReplicaSets(rollout *v1alpha1.Rollout, currentHash string) error {
+	rsList, err := c.replicaSetLister.ReplicaSets(rollout.Namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, rs := range rsList {
+		if !metav1.IsControlledBy(rs, rollout) {
+			continue
+		}
+		if rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] == currentHash {
+			continue
+		}
+		if rs.Spec.Replicas == nil || *rs.Spec.Replicas == 0 {
+			continue
+		}
+		if err := c.scaleReplicaSetToZero(rs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Controller) patchServiceSelector(svc *corev1.Service, podHash string) error {
+	patch := fmt.Sprintf(`{"spec":{"selector":{"%s":"%s"}}}`, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+	_, err := c.kubeclientset.CoreV1().Services(svc.Namespace).Patch(context.TODO(), svc.Name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+// appendDegradedCondition records a required pre- or post-promotion hook failure on the rollout
+// so it is observable on the resource instead of only inferable from the (unchanged) service
+// selector.
+func appendDegradedCondition(rollout *v1alpha1.Rollout, err error) {
+	cond := conditions.NewRolloutCondition(v1alpha1.RolloutDegraded, corev1.ConditionTrue, v1alpha1.HookFailed, err.Error())
+	rollout.Status.Conditions = append(rollout.Status.Conditions, *cond)
+}
+
+// patchRolloutStatus replaces the whole of rollout.Status via a JSON patch against the status
+// subresource, so fields cleared in memory (nil pointers, empty strings) are cleared on the server
+// too.
+func (c *Controller) patchRolloutStatus(rollout *v1alpha1.Rollout) error {
+	patch, err := json.Marshal([]map[string]interface{}{{"op": "add", "path": "/status", "value": rollout.Status}})
+	if err != nil {
+		return err
+	}
+	_, err = c.rolloutsclientset.ArgoprojV1alpha1().Rollouts(rollout.Namespace).
+		Patch(context.TODO(), rollout.Name, types.JSONPatchType, patch, metav1.PatchOptions{}, "status")
+	return err
+}
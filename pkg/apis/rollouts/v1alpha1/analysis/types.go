@@ -0,0 +1,150 @@
+package analysis
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AnalysisTemplate is a template that defines a re-usable set of metric queries used to verify
+// the health of a Rollout's new ReplicaSet before promotion.
+type AnalysisTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AnalysisTemplateSpec `json:"spec"`
+}
+
+// AnalysisTemplateSpec is the spec for an AnalysisTemplate resource
+type AnalysisTemplateSpec struct {
+	// Metrics contains the list of metrics to query as part of the analysis run
+	Metrics []Metric `json:"metrics"`
+}
+
+// Metric defines a metric that an AnalysisRun will query, along with the success criteria
+type Metric struct {
+	// Name is the name of the metric
+	Name string `json:"name"`
+	// Interval defines an interval string (e.g. 30s, 5m, 1h) between each measurement.
+	// The analysis controller does not read this field; it takes a single measurement of each
+	// metric.
+	// +optional
+	Interval string `json:"interval,omitempty"`
+	// Count is the number of times to run the measurement. The analysis controller does not read
+	// this field; it takes a single measurement of each metric.
+	// +optional
+	Count int32 `json:"count,omitempty"`
+	// SuccessCondition is an expression which, if evaluated true, signifies that the measurement
+	// was considered successful
+	SuccessCondition string `json:"successCondition,omitempty"`
+	// Provider specifies the metric provider that should be queried for this metric
+	Provider MetricProvider `json:"provider"`
+}
+
+// MetricProvider describes which provider to use to produce a measurement
+type MetricProvider struct {
+	// +optional
+	Prometheus *PrometheusMetric `json:"prometheus,omitempty"`
+	// +optional
+	Datadog *DatadogMetric `json:"datadog,omitempty"`
+	// +optional
+	Web *WebMetric `json:"web,omitempty"`
+}
+
+// PrometheusMetric defines the prometheus query to perform canary analysis
+type PrometheusMetric struct {
+	// Address is the HTTP address and port of the prometheus server
+	Address string `json:"address"`
+	// Query is a raw prometheus query to perform
+	Query string `json:"query"`
+}
+
+// DatadogMetric defines the datadog query to perform canary analysis
+type DatadogMetric struct {
+	// Query is the datadog query to perform
+	Query string `json:"query"`
+	// Interval is the datadog query window
+	Interval string `json:"interval,omitempty"`
+}
+
+// WebMetric defines a generic HTTP web endpoint to query as a metric provider
+type WebMetric struct {
+	// URL is the address of the web metric
+	URL string `json:"url"`
+	// JSONPath is the path within the response body to extract the measurement from
+	JSONPath string `json:"jsonPath"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AnalysisTemplateList is a list of AnalysisTemplate resources
+type AnalysisTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AnalysisTemplate `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AnalysisRun is an instantiation of an AnalysisTemplate, created by the Rollout controller to
+// verify a preview ReplicaSet before it is promoted to the active service
+type AnalysisRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AnalysisRunSpec   `json:"spec"`
+	Status AnalysisRunStatus `json:"status,omitempty"`
+}
+
+// AnalysisRunSpec is the spec for an AnalysisRun resource
+type AnalysisRunSpec struct {
+	// Metrics contains the list of metrics to query as part of the analysis run
+	Metrics []Metric `json:"metrics"`
+}
+
+// AnalysisPhase is the status of an AnalysisRun or a given metric within it
+type AnalysisPhase string
+
+// These are valid phases of an AnalysisRun
+const (
+	AnalysisPhasePending    AnalysisPhase = "Pending"
+	AnalysisPhaseRunning    AnalysisPhase = "Running"
+	AnalysisPhaseSuccessful AnalysisPhase = "Successful"
+	AnalysisPhaseFailed     AnalysisPhase = "Failed"
+)
+
+// AnalysisRunStatus is the status for an AnalysisRun resource
+type AnalysisRunStatus struct {
+	// Phase is the status of the analysis run
+	Phase AnalysisPhase `json:"phase,omitempty"`
+	// Message is a message explaining the current status
+	// +optional
+	Message string `json:"message,omitempty"`
+	// MetricResults contains the result of each metric that was queried
+	// +optional
+	MetricResults []MetricResult `json:"metricResults,omitempty"`
+}
+
+// MetricResult contains the result of a single metric's measurements
+type MetricResult struct {
+	// Name is the name of the metric, corresponding to Metric.Name
+	Name string `json:"name"`
+	// Phase is the last observed phase of this metric
+	Phase AnalysisPhase `json:"phase"`
+	// Value is the last measured value
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AnalysisRunList is a list of AnalysisRun resources
+type AnalysisRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AnalysisRun `json:"items"`
+}
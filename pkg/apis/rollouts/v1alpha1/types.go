@@ -0,0 +1,342 @@
+package v1alpha1
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultRolloutUniqueLabelKey is the default key of the selector that is added
+// to existing ReplicaSets (and label key that is added to its pods) to prevent the existing ReplicaSets
+// to select new pods (and vice versa).
+const DefaultRolloutUniqueLabelKey = "rollouts-pod-template-hash"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Rollout is a specification for a Rollout resource
+type Rollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RolloutSpec   `json:"spec"`
+	Status RolloutStatus `json:"status,omitempty"`
+}
+
+// RolloutSpec is the spec for a Rollout resource
+type RolloutSpec struct {
+	// Number of desired pods. Defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Label selector for pods.
+	Selector *metav1.LabelSelector `json:"selector"`
+	// Template describes the pods that will be created.
+	Template corev1.PodTemplateSpec `json:"template"`
+	// MinReadySeconds is the minimum number of seconds for which a newly created pod should
+	// be ready without any of its container crashing, for it to be considered available.
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+	// Strategy defines the rollout strategy that will be applied to update the Rollout
+	Strategy RolloutStrategy `json:"strategy"`
+	// RevisionHistoryLimit is the number of old ReplicaSets to retain to allow rollback.
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+	// Paused pauses the rollout at its current step.
+	Paused bool `json:"paused,omitempty"`
+	// ProgressDeadlineSeconds is the maximum time in seconds for a rollout to
+	// make progress before it is considered to be failed. When neither this field nor
+	// BlueGreenStrategy.ProgressDeadlineSeconds is set, no progress deadline is enforced.
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+}
+
+// RolloutStrategy defines strategy to apply during next rollout
+type RolloutStrategy struct {
+	// +optional
+	BlueGreenStrategy *BlueGreenStrategy `json:"blueGreenStrategy,omitempty"`
+	// +optional
+	CanaryStrategy *CanaryStrategy `json:"canaryStrategy,omitempty"`
+}
+
+// JobTemplateSpec describes the data a Job should have when created from a template. batchv1
+// has no equivalent of its own (the wrapper only exists alongside CronJob in batch/v1beta1), so
+// RolloutHook defines it directly.
+type JobTemplateSpec struct {
+	// Standard object's metadata of the Job created from this template.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the Job's desired behavior
+	Spec batchv1.JobSpec `json:"spec,omitempty"`
+}
+
+// RolloutHook is a job template used to run a pre/post-promotion hook during a BlueGreen rollout
+type RolloutHook struct {
+	// Name is the name of the hook, used to correlate the hook's status in RolloutStatus
+	Name string `json:"name"`
+	// JobTemplate is the job spec that should be run
+	JobTemplate JobTemplateSpec `json:"jobTemplate"`
+	// BackoffLimit is the number of retries before marking the hook Job as failed
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// TimeoutSeconds is the duration the controller will wait for the hook Job to complete
+	// before considering it failed
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// BlueGreenStrategy defines parameters for Blue Green deployment
+type BlueGreenStrategy struct {
+	// ActiveService is the name of the service that the rollout modifies as the active service.
+	ActiveService string `json:"activeService"`
+	// PreviewService is the name of the service that the rollout modifies as the preview service.
+	// +optional
+	PreviewService string `json:"previewService,omitempty"`
+	// PreviewReplicaCount is the number of replicas to run for the preview stack before the
+	// switchover. The controller does not read this field; the new ReplicaSet is always created
+	// with spec.replicas.
+	// +optional
+	PreviewReplicaCount *int32 `json:"previewReplicaCount,omitempty"`
+	// AutoPromotionEnabled indicates if the rollout should automatically promote the new
+	// ReplicaSet to the active service once the new ReplicaSet is fully available.
+	// +optional
+	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty"`
+	// PrePromotionHooks is a list of hooks that must all run to completion before the active
+	// service selector is switched to the new ReplicaSet.
+	// +optional
+	PrePromotionHooks []RolloutHook `json:"prePromotionHooks,omitempty"`
+	// PostPromotionHooks is a list of hooks that must all run to completion before the old
+	// ReplicaSet is scaled down.
+	// +optional
+	PostPromotionHooks []RolloutHook `json:"postPromotionHooks,omitempty"`
+	// AnalysisTemplateName references an AnalysisTemplate to instantiate as an AnalysisRun once
+	// the preview ReplicaSet is fully available. The active service selector is not switched to
+	// the new ReplicaSet until the AnalysisRun reaches the Successful phase.
+	// +optional
+	AnalysisTemplateName string `json:"analysisTemplateName,omitempty"`
+	// ProgressDeadlineSeconds overrides RolloutSpec.ProgressDeadlineSeconds for the time the
+	// preview ReplicaSet is given to become fully available and pass verification before the
+	// rollout is considered to have failed to progress.
+	// +optional
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+	// AutoRollbackOnFailure indicates that, once ProgressDeadlineSeconds is exceeded, the
+	// controller should patch the active service selector back to Status.StableRS and scale the
+	// failed preview ReplicaSet down to zero.
+	// +optional
+	AutoRollbackOnFailure bool `json:"autoRollbackOnFailure,omitempty"`
+	// TrafficRouting configures a service mesh or ingress controller to progressively shift a
+	// percentage of traffic to the preview ReplicaSet across Steps, instead of switching the
+	// active Service selector all at once.
+	// +optional
+	TrafficRouting *TrafficRouting `json:"trafficRouting,omitempty"`
+}
+
+// TrafficRouting configures how a BlueGreen rollout shifts traffic between the stable and
+// preview ReplicaSets. Exactly one of Istio, SMI or Nginx should be set.
+type TrafficRouting struct {
+	// Istio configures Istio VirtualService to split traffic between the stable and preview
+	// subsets.
+	// +optional
+	Istio *IstioTrafficRouting `json:"istio,omitempty"`
+	// SMI configures an SMI TrafficSplit to split traffic between the stable and preview
+	// backends.
+	// +optional
+	SMI *SMITrafficRouting `json:"smi,omitempty"`
+	// Nginx configures an NGINX Ingress canary annotation to split traffic between the stable and
+	// preview services.
+	// +optional
+	Nginx *NginxTrafficRouting `json:"nginx,omitempty"`
+	// Steps define the order of weight changes and pauses to execute during the traffic shift.
+	// +optional
+	Steps []CanaryStep `json:"steps,omitempty"`
+}
+
+// IstioTrafficRouting references an Istio VirtualService to progressively update
+type IstioTrafficRouting struct {
+	// VirtualService is the name of the VirtualService to modify
+	VirtualService string `json:"virtualService"`
+}
+
+// SMITrafficRouting references an SMI TrafficSplit to progressively update
+type SMITrafficRouting struct {
+	// TrafficSplit is the name of the TrafficSplit to modify
+	TrafficSplit string `json:"trafficSplit"`
+}
+
+// NginxTrafficRouting references an NGINX Ingress to progressively update via canary annotations
+type NginxTrafficRouting struct {
+	// StableIngress is the name of the Ingress that NGINX uses for the stable service
+	StableIngress string `json:"stableIngress"`
+}
+
+// CanaryStrategy defines parameters for a Canary deployment
+type CanaryStrategy struct {
+	// Steps define the order of phases to execute the canary deployment
+	// +optional
+	Steps []CanaryStep `json:"steps,omitempty"`
+}
+
+// CanaryStep defines a step of a canary deployment
+type CanaryStep struct {
+	// SetWeight sets what percentage of the newRS should receive
+	// +optional
+	SetWeight *int32 `json:"setWeight,omitempty"`
+	// Pause defines a pause stage for a rollout
+	// +optional
+	Pause *RolloutPause `json:"pause,omitempty"`
+}
+
+// RolloutPause defines a pause stage for a rollout
+type RolloutPause struct {
+	// Duration the amount of time to wait before moving to the next step.
+	// +optional
+	Duration *int32 `json:"duration,omitempty"`
+}
+
+// HookPhase is a label for the condition of a hook Job at the current time
+type HookPhase string
+
+// These are valid phases of a hook Job
+const (
+	HookPhasePending    HookPhase = "Pending"
+	HookPhaseRunning    HookPhase = "Running"
+	HookPhaseSuccessful HookPhase = "Successful"
+	HookPhaseFailed     HookPhase = "Failed"
+)
+
+// HookStatus tracks the status of a pre/post-promotion hook Job
+type HookStatus struct {
+	// Name is the name of the hook, corresponding to RolloutHook.Name
+	Name string `json:"name"`
+	// Phase is the last observed phase of the hook Job
+	Phase HookPhase `json:"phase"`
+	// StartTime is the time the hook Job was created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the hook Job reached a terminal phase
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message is a human readable message indicating details about why the hook is in this phase
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BlueGreenStatus holds the status of fields specific to the BlueGreen strategy
+type BlueGreenStatus struct {
+	// PreviewStartTime is the time the preview ReplicaSet was created. It is used, together with
+	// BlueGreenStrategy.ProgressDeadlineSeconds, to detect a preview stack that failed to become
+	// available and pass verification in time.
+	// +optional
+	PreviewStartTime *metav1.Time `json:"previewStartTime,omitempty"`
+	// CurrentStepStartTime is the time the rollout entered TrafficRouting.Steps[CurrentStepIndex].
+	// It is used to know when a Pause step with a Duration has elapsed.
+	// +optional
+	CurrentStepStartTime *metav1.Time `json:"currentStepStartTime,omitempty"`
+}
+
+// RolloutStatus is the status for a Rollout resource
+type RolloutStatus struct {
+	// BlueGreen holds the status of fields specific to the BlueGreen strategy
+	// +optional
+	BlueGreen BlueGreenStatus `json:"blueGreen,omitempty"`
+	// CurrentPodHash the hash of the current pod template
+	// +optional
+	CurrentPodHash string `json:"currentPodHash,omitempty"`
+	// CurrentStepHash the hash of the current step
+	// +optional
+	CurrentStepHash string `json:"currentStepHash,omitempty"`
+	// Count of hash collisions for the Rollout.
+	// +optional
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+	// The generation observed by the rollout controller.
+	// +optional
+	ObservedGeneration string `json:"observedGeneration,omitempty"`
+	// CurrentStepIndex defines the current step of the rollout is on.
+	// +optional
+	CurrentStepIndex *int32 `json:"currentStepIndex,omitempty"`
+	// Total number of non-terminated pods targeted by this rollout (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// Total number of non-terminated pods targeted by this rollout that have the desired template spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// Total number of ready pods targeted by this rollout.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Total number of available pods (ready for at least minReadySeconds) targeted by this rollout.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// VerifyingPreview indicates that the rollout is waiting for the preview stack to be
+	// verified before proceeding with the blue-green promotion. A human, or an automated
+	// verification system, sets this to false to unblock the promotion.
+	// +optional
+	VerifyingPreview *bool `json:"verifyingPreview,omitempty"`
+	// StableRS indicates the pod hash of the ReplicaSet that was last promoted to the active
+	// service, used to roll back to if a subsequent rollout fails.
+	// +optional
+	StableRS string `json:"stableRS,omitempty"`
+	// PrePromotionHookStatuses tracks the status of each PrePromotionHooks Job
+	// +optional
+	PrePromotionHookStatuses []HookStatus `json:"prePromotionHookStatuses,omitempty"`
+	// PostPromotionHookStatuses tracks the status of each PostPromotionHooks Job
+	// +optional
+	PostPromotionHookStatuses []HookStatus `json:"postPromotionHookStatuses,omitempty"`
+	// CurrentAnalysisRun is the name of the AnalysisRun that is currently verifying the preview
+	// ReplicaSet before it is promoted to the active service.
+	// +optional
+	CurrentAnalysisRun string `json:"currentAnalysisRun,omitempty"`
+	// Conditions a list of conditions a rollout can have.
+	// +optional
+	Conditions []RolloutCondition `json:"conditions,omitempty"`
+}
+
+// RolloutConditionType defines the conditions of Rollout
+type RolloutConditionType string
+
+// These are valid conditions of a rollout.
+const (
+	// RolloutAvailable means the rollout is serving traffic from the active service.
+	RolloutAvailable RolloutConditionType = "Available"
+	// RolloutProgressing means the rollout is progressing. Progress for a rollout is
+	// considered when a new replica set is created or adopted, and when new pods scale
+	// up or old pods scale down.
+	RolloutProgressing RolloutConditionType = "Progressing"
+	// AnalysisFailed means that the AnalysisRun verifying the preview ReplicaSet failed, and
+	// the active service has been left pointing at the stable ReplicaSet.
+	AnalysisFailed RolloutConditionType = "AnalysisFailed"
+	// RolloutDegraded means a required pre- or post-promotion hook failed, so the rollout could
+	// not progress to or finish a promotion on its own.
+	RolloutDegraded RolloutConditionType = "Degraded"
+)
+
+// Reasons for rollout conditions
+const (
+	// ProgressDeadlineExceeded is added in a rollout when its progress fails to show any increase
+	// within the configured progressDeadlineSeconds, mirroring the Deployment controller's
+	// semantics of the same name.
+	ProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+	// HookFailed is added in a rollout when a required pre- or post-promotion hook job fails.
+	HookFailed = "HookFailed"
+	// AnalysisRunFailed is added in a rollout when the AnalysisRun verifying the preview
+	// ReplicaSet fails.
+	AnalysisRunFailed = "AnalysisRunFailed"
+)
+
+// RolloutCondition describes the state of a rollout at a certain point.
+type RolloutCondition struct {
+	// Type of rollout condition.
+	Type RolloutConditionType `json:"type"`
+	// Phase of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RolloutList is a list of Rollout resources
+type RolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Rollout `json:"items"`
+}
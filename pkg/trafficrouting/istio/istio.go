@@ -0,0 +1,84 @@
+package istio
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// VirtualServiceGVR is the GroupVersionResource of an Istio VirtualService
+var VirtualServiceGVR = schema.GroupVersionResource{
+	Group:    "networking.istio.io",
+	Version:  "v1alpha3",
+	Resource: "virtualservices",
+}
+
+// Reconciler updates the HTTP route weights of an Istio VirtualService to shift traffic between
+// the stable and preview subsets of a BlueGreen rollout.
+type Reconciler struct {
+	rollout *v1alpha1.Rollout
+	client  dynamic.Interface
+}
+
+// NewReconciler returns a traffic routing reconciler backed by the given dynamic client
+func NewReconciler(rollout *v1alpha1.Rollout, client dynamic.Interface) *Reconciler {
+	return &Reconciler{rollout: rollout, client: client}
+}
+
+// SetWeight patches every HTTP route destination of the configured VirtualService so that the
+// preview (canary) subset receives weight percent of traffic, and the stable subset the rest.
+func (r *Reconciler) SetWeight(stable, canary string, weight int32) error {
+	istioTrafficRouting := r.rollout.Spec.Strategy.BlueGreenStrategy.TrafficRouting.Istio
+	vsClient := r.client.Resource(VirtualServiceGVR).Namespace(r.rollout.Namespace)
+	vs, err := vsClient.Get(context.TODO(), istioTrafficRouting.VirtualService, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	httpRoutes, _, err := unstructured.NestedSlice(vs.Object, "spec", "http")
+	if err != nil {
+		return err
+	}
+	for _, route := range httpRoutes {
+		routeMap, ok := route.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		destinations, _, err := unstructured.NestedSlice(routeMap, "route")
+		if err != nil {
+			return err
+		}
+		for _, destination := range destinations {
+			destMap, ok := destination.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			host, _, _ := unstructured.NestedString(destMap, "destination", "subset")
+			switch host {
+			case stable:
+				unstructured.SetNestedField(destMap, int64(100-weight), "weight")
+			case canary:
+				unstructured.SetNestedField(destMap, int64(weight), "weight")
+			}
+		}
+		if err := unstructured.SetNestedSlice(routeMap, destinations, "route"); err != nil {
+			return err
+		}
+	}
+	if err := unstructured.SetNestedSlice(vs.Object, httpRoutes, "spec", "http"); err != nil {
+		return err
+	}
+	_, err = vsClient.Update(context.TODO(), vs, metav1.UpdateOptions{})
+	return err
+}
+
+// Verify reports whether the VirtualService has finished propagating the last weight change.
+// Istio's control plane applies VirtualService changes asynchronously with no observable status,
+// so we consider the change verified as soon as it is persisted to the API server.
+func (r *Reconciler) Verify() (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,88 @@
+package istio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newUnstructuredVirtualService(name, namespace, stableSubset, canarySubset string, stableWeight, canaryWeight int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "networking.istio.io/v1alpha3",
+			"kind":       "VirtualService",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"http": []interface{}{
+					map[string]interface{}{
+						"route": []interface{}{
+							map[string]interface{}{
+								"destination": map[string]interface{}{"subset": stableSubset},
+								"weight":      stableWeight,
+							},
+							map[string]interface{}{
+								"destination": map[string]interface{}{"subset": canarySubset},
+								"weight":      canaryWeight,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newRolloutWithIstio(vsName string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				BlueGreenStrategy: &v1alpha1.BlueGreenStrategy{
+					ActiveService: "active",
+					TrafficRouting: &v1alpha1.TrafficRouting{
+						Istio: &v1alpha1.IstioTrafficRouting{VirtualService: vsName},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestReconcilerSetWeightUpdatesRouteDestinations(t *testing.T) {
+	rollout := newRolloutWithIstio("rollout-vsvc")
+	vs := newUnstructuredVirtualService("rollout-vsvc", rollout.Namespace, "stable", "canary", 100, 0)
+
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), vs)
+
+	r := NewReconciler(rollout, client)
+	err := r.SetWeight("stable", "canary", 30)
+	assert.NoError(t, err)
+
+	updated, err := client.Resource(VirtualServiceGVR).Namespace(rollout.Namespace).Get(context.TODO(), "rollout-vsvc", metav1.GetOptions{})
+	assert.NoError(t, err)
+	httpRoutes, _, _ := unstructured.NestedSlice(updated.Object, "spec", "http")
+	routes, _, _ := unstructured.NestedSlice(httpRoutes[0].(map[string]interface{}), "route")
+	stableWeight, _, _ := unstructured.NestedInt64(routes[0].(map[string]interface{}), "weight")
+	canaryWeight, _, _ := unstructured.NestedInt64(routes[1].(map[string]interface{}), "weight")
+	assert.Equal(t, int64(70), stableWeight)
+	assert.Equal(t, int64(30), canaryWeight)
+}
+
+func TestReconcilerVerifyAlwaysTrue(t *testing.T) {
+	rollout := newRolloutWithIstio("rollout-vsvc")
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	r := NewReconciler(rollout, client)
+	verified, err := r.Verify()
+	assert.NoError(t, err)
+	assert.True(t, verified)
+}
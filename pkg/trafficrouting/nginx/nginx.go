@@ -0,0 +1,66 @@
+package nginx
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// IngressGVR is the GroupVersionResource of a networking.k8s.io Ingress
+var IngressGVR = schema.GroupVersionResource{
+	Group:    "networking.k8s.io",
+	Version:  "v1",
+	Resource: "ingresses",
+}
+
+// canaryWeightAnnotation is the NGINX Ingress annotation that controls what percentage of
+// traffic is routed to the canary Ingress
+const canaryWeightAnnotation = "nginx.ingress.kubernetes.io/canary-weight"
+
+// Reconciler sets the canary-weight annotation on a "<StableIngress>-canary" Ingress to shift
+// traffic between the stable and preview services of a BlueGreen rollout.
+type Reconciler struct {
+	rollout *v1alpha1.Rollout
+	client  dynamic.Interface
+}
+
+// NewReconciler returns a traffic routing reconciler backed by the given dynamic client
+func NewReconciler(rollout *v1alpha1.Rollout, client dynamic.Interface) *Reconciler {
+	return &Reconciler{rollout: rollout, client: client}
+}
+
+func canaryIngressName(stableIngress string) string {
+	return fmt.Sprintf("%s-canary", stableIngress)
+}
+
+// SetWeight sets the canary-weight annotation on the canary Ingress to weight. The stable and
+// canary service names are accepted to satisfy the TrafficRoutingReconciler interface, but NGINX
+// derives the split purely from the annotation on the existing canary Ingress.
+func (r *Reconciler) SetWeight(stable, canary string, weight int32) error {
+	nginxTrafficRouting := r.rollout.Spec.Strategy.BlueGreenStrategy.TrafficRouting.Nginx
+	ingClient := r.client.Resource(IngressGVR).Namespace(r.rollout.Namespace)
+	canaryIngress, err := ingClient.Get(context.TODO(), canaryIngressName(nginxTrafficRouting.StableIngress), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	annotations := canaryIngress.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[canaryWeightAnnotation] = fmt.Sprintf("%d", weight)
+	canaryIngress.SetAnnotations(annotations)
+	_, err = ingClient.Update(context.TODO(), canaryIngress, metav1.UpdateOptions{})
+	return err
+}
+
+// Verify reports whether the canary Ingress has finished propagating the last weight change.
+// NGINX exposes no common status for canary annotations, so the change is considered verified as
+// soon as it is persisted to the API server.
+func (r *Reconciler) Verify() (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,71 @@
+package smi
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// TrafficSplitGVR is the GroupVersionResource of an SMI TrafficSplit
+var TrafficSplitGVR = schema.GroupVersionResource{
+	Group:    "split.smi-spec.io",
+	Version:  "v1alpha1",
+	Resource: "trafficsplits",
+}
+
+// Reconciler updates the backend weights of an SMI TrafficSplit to shift traffic between the
+// stable and preview backends of a BlueGreen rollout.
+type Reconciler struct {
+	rollout *v1alpha1.Rollout
+	client  dynamic.Interface
+}
+
+// NewReconciler returns a traffic routing reconciler backed by the given dynamic client
+func NewReconciler(rollout *v1alpha1.Rollout, client dynamic.Interface) *Reconciler {
+	return &Reconciler{rollout: rollout, client: client}
+}
+
+// SetWeight patches every backend of the configured TrafficSplit so that the preview (canary)
+// backend receives weight percent of traffic, and the stable backend the rest.
+func (r *Reconciler) SetWeight(stable, canary string, weight int32) error {
+	smiTrafficRouting := r.rollout.Spec.Strategy.BlueGreenStrategy.TrafficRouting.SMI
+	tsClient := r.client.Resource(TrafficSplitGVR).Namespace(r.rollout.Namespace)
+	ts, err := tsClient.Get(context.TODO(), smiTrafficRouting.TrafficSplit, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	backends, _, err := unstructured.NestedSlice(ts.Object, "spec", "backends")
+	if err != nil {
+		return err
+	}
+	for _, backend := range backends {
+		backendMap, ok := backend.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service, _, _ := unstructured.NestedString(backendMap, "service")
+		switch service {
+		case stable:
+			unstructured.SetNestedField(backendMap, int64(100-weight), "weight")
+		case canary:
+			unstructured.SetNestedField(backendMap, int64(weight), "weight")
+		}
+	}
+	if err := unstructured.SetNestedSlice(ts.Object, backends, "spec", "backends"); err != nil {
+		return err
+	}
+	_, err = tsClient.Update(context.TODO(), ts, metav1.UpdateOptions{})
+	return err
+}
+
+// Verify reports whether the TrafficSplit has finished propagating the last weight change. SMI
+// implementations expose no common status field, so the change is considered verified as soon as
+// it is persisted to the API server.
+func (r *Reconciler) Verify() (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,43 @@
+package trafficrouting
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/trafficrouting/istio"
+	"github.com/argoproj/argo-rollouts/pkg/trafficrouting/nginx"
+	"github.com/argoproj/argo-rollouts/pkg/trafficrouting/smi"
+)
+
+// TrafficRoutingReconciler is implemented by each supported traffic-splitting provider (Istio,
+// SMI, NGINX) so the BlueGreen controller can progressively shift weighted traffic to the preview
+// ReplicaSet without needing to know the provider-specific resource shape.
+type TrafficRoutingReconciler interface {
+	// SetWeight updates the provider's routing object so that weight percent of traffic is sent
+	// to canary, and the remainder to stable.
+	SetWeight(stable, canary string, weight int32) error
+	// Verify returns whether the routing object has caught up to the weight set by the last
+	// SetWeight call (e.g. the mesh control plane has finished propagating the change).
+	Verify() (bool, error)
+}
+
+// NewTrafficRoutingReconciler returns the reconciler for whichever provider is configured on the
+// rollout's BlueGreenStrategy.TrafficRouting, or nil if none is configured.
+func NewTrafficRoutingReconciler(rollout *v1alpha1.Rollout, dynamicClient dynamic.Interface) (TrafficRoutingReconciler, error) {
+	trafficRouting := rollout.Spec.Strategy.BlueGreenStrategy.TrafficRouting
+	if trafficRouting == nil {
+		return nil, nil
+	}
+	switch {
+	case trafficRouting.Istio != nil:
+		return istio.NewReconciler(rollout, dynamicClient), nil
+	case trafficRouting.SMI != nil:
+		return smi.NewReconciler(rollout, dynamicClient), nil
+	case trafficRouting.Nginx != nil:
+		return nginx.NewReconciler(rollout, dynamicClient), nil
+	default:
+		return nil, fmt.Errorf("no traffic routing provider configured on rollout %s/%s", rollout.Namespace, rollout.Name)
+	}
+}
@@ -0,0 +1,28 @@
+package annotations
+
+import (
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// RevisionAnnotation is the revision annotation of a rollout's replica sets which records
+	// its rollout sequence
+	RevisionAnnotation = "rollout.argoproj.io/revision"
+
+	// DesiredReplicasAnnotation is the desired replicas for a rollout recorded as an annotation
+	// on its replica sets. Helps deployments controller to apply the same proportional scaling
+	// logic for its replica sets.
+	DesiredReplicasAnnotation = "rollout.argoproj.io/desired-replicas"
+)
+
+// SetRolloutRevision updates the revision for a rollout.
+func SetRolloutRevision(rollout *v1alpha1.Rollout, revision string) bool {
+	if rollout.Annotations == nil {
+		rollout.Annotations = make(map[string]string)
+	}
+	if rollout.Annotations[RevisionAnnotation] == revision {
+		return false
+	}
+	rollout.Annotations[RevisionAnnotation] = revision
+	return true
+}
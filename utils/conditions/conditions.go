@@ -0,0 +1,52 @@
+package conditions
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// ComputeStepHash returns a hash value calculated from the Rollout's steps, whether they came
+// from the CanaryStrategy or from a BlueGreenStrategy's TrafficRouting. The hash will be safe
+// encoded to avoid bad words.
+func ComputeStepHash(rollout *v1alpha1.Rollout) string {
+	steps := rolloutSteps(rollout)
+	if len(steps) == 0 {
+		return ""
+	}
+	stepsBytes, err := json.Marshal(steps)
+	if err != nil {
+		return ""
+	}
+	hasher := fnv.New32a()
+	hasher.Write(stepsBytes)
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
+
+func rolloutSteps(rollout *v1alpha1.Rollout) []v1alpha1.CanaryStep {
+	if rollout.Spec.Strategy.CanaryStrategy != nil {
+		return rollout.Spec.Strategy.CanaryStrategy.Steps
+	}
+	if blueGreen := rollout.Spec.Strategy.BlueGreenStrategy; blueGreen != nil && blueGreen.TrafficRouting != nil {
+		return blueGreen.TrafficRouting.Steps
+	}
+	return nil
+}
+
+// NewRolloutCondition creates a new rollout condition.
+func NewRolloutCondition(condType v1alpha1.RolloutConditionType, status corev1.ConditionStatus, reason, message string) *v1alpha1.RolloutCondition {
+	return &v1alpha1.RolloutCondition{
+		Type:               condType,
+		Status:             status,
+		LastUpdateTime:     metav1.Now(),
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
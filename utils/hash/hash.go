@@ -0,0 +1,46 @@
+// Package hash computes the pod-template-hash label used to distinguish the ReplicaSets owned by
+// a Rollout. It reimplements the same algorithm as k8s.io/kubernetes/pkg/controller.ComputeHash so
+// that ReplicaSets created by this controller are named and labeled consistently with the
+// Deployment controller's convention, without pulling in the entire k8s.io/kubernetes module as a
+// dependency.
+package hash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+
+	"github.com/davecgh/go-spew/spew"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// ComputeHash returns a hash value calculated from pod template and a collisionCount to avoid
+// hash collision. The hash will be safe encoded to avoid bad words.
+func ComputeHash(template *corev1.PodTemplateSpec, collisionCount *int32) string {
+	podTemplateSpecHasher := fnv.New32a()
+	deepHashObject(podTemplateSpecHasher, *template)
+
+	if collisionCount != nil {
+		collisionCountBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(collisionCountBytes, uint64(*collisionCount))
+		podTemplateSpecHasher.Write(collisionCountBytes)
+	}
+
+	return rand.SafeEncodeString(fmt.Sprint(podTemplateSpecHasher.Sum32()))
+}
+
+// deepHashObject writes specified object to hash using the spew library which follows pointers
+// and prints actual values of the nested objects, ensuring the hash does not change when a pointer
+// changes.
+func deepHashObject(hasher hash.Hash, objectToWrite interface{}) {
+	hasher.Reset()
+	printer := spew.ConfigState{
+		Indent:         " ",
+		SortKeys:       true,
+		DisableMethods: true,
+		SpewKeys:       true,
+	}
+	printer.Fprintf(hasher, "%#v", objectToWrite)
+}